@@ -1,9 +1,22 @@
 package conns
 
 import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
@@ -82,6 +95,309 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestAWSClientConnLazyInitialization(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &AWSClient{
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+		endpoints: map[string]string{},
+		session:   sess,
+	}
+
+	if got := len(client.conns); got != 0 {
+		t.Fatalf("expected no service clients before first use, got %d", got)
+	}
+
+	var wg sync.WaitGroup
+	s3Conns := make(chan interface{}, 10)
+
+	for i := 0; i < cap(s3Conns); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s3Conns <- client.S3Conn()
+		}()
+	}
+
+	wg.Wait()
+	close(s3Conns)
+
+	s3Conn := client.S3Conn()
+	for conn := range s3Conns {
+		if conn != s3Conn {
+			t.Fatalf("expected the same S3 client from every call")
+		}
+	}
+
+	if got, expected := aws.StringValue(client.IAMConn().Config.Region), endpoints.UsWest2RegionID; got != expected {
+		t.Errorf("got IAM client region %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(client.Route53Conn().Config.Region), endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("got Route 53 client region %s, expected %s", got, expected)
+	}
+
+	var keys []string
+	for k := range client.conns {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if expected := []string{IAM, Route53, S3}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("got constructed service clients %q, expected %q", keys, expected)
+	}
+}
+
+func TestConfigClientEndpointVariants(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		UseDualStackEndpoint bool
+		UseFIPSEndpoint      bool
+		ExpectedEndpoints    map[string]string
+	}{
+		{
+			Name: "default",
+			ExpectedEndpoints: map[string]string{
+				EC2:               "https://ec2.us-west-2.amazonaws.com",
+				GlobalAccelerator: "https://globalaccelerator.us-west-2.amazonaws.com",
+				Route53:           "https://route53.amazonaws.com",
+				Shield:            "https://shield.us-east-1.amazonaws.com",
+			},
+		},
+		{
+			Name:                 "dual-stack",
+			UseDualStackEndpoint: true,
+			ExpectedEndpoints: map[string]string{
+				EC2:               "https://ec2.us-west-2.api.aws",
+				GlobalAccelerator: "https://globalaccelerator.us-west-2.amazonaws.com",
+				Route53:           "https://route53.amazonaws.com",
+				Shield:            "https://shield.us-east-1.amazonaws.com",
+			},
+		},
+		{
+			Name:            "FIPS",
+			UseFIPSEndpoint: true,
+			ExpectedEndpoints: map[string]string{
+				EC2:               "https://ec2-fips.us-west-2.amazonaws.com",
+				GlobalAccelerator: "https://globalaccelerator-fips.us-west-2.amazonaws.com",
+				Route53:           "https://route53-fips.amazonaws.com",
+				Shield:            "https://shield-fips.us-east-1.amazonaws.com",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AccessKey:               "accesskey",
+				Region:                  endpoints.UsWest2RegionID,
+				SecretKey:               "secretkey",
+				SkipCredsValidation:     true,
+				SkipGetEC2Platforms:     true,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+				UseDualStackEndpoint:    testCase.UseDualStackEndpoint,
+				UseFIPSEndpoint:         testCase.UseFIPSEndpoint,
+			}
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			client := raw.(*AWSClient)
+			got := map[string]string{
+				EC2:               client.EC2Conn().Endpoint,
+				GlobalAccelerator: client.GlobalAcceleratorConn().Endpoint,
+				Route53:           client.Route53Conn().Endpoint,
+				Shield:            client.ShieldConn().Endpoint,
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedEndpoints) {
+				t.Errorf("got endpoints %v, expected %v", got, testCase.ExpectedEndpoints)
+			}
+		})
+	}
+}
+
+func TestConfigClientUseFIPSEndpoint(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		UseFIPSEndpoint  bool
+		EnvValue         string
+		ExpectedEndpoint string
+	}{
+		{
+			Name:             "default",
+			ExpectedEndpoint: "https://ec2.us-west-2.amazonaws.com",
+		},
+		{
+			Name:             "configured",
+			UseFIPSEndpoint:  true,
+			ExpectedEndpoint: "https://ec2-fips.us-west-2.amazonaws.com",
+		},
+		{
+			Name:             "environment variable",
+			EnvValue:         "true",
+			ExpectedEndpoint: "https://ec2-fips.us-west-2.amazonaws.com",
+		},
+		{
+			Name:             "environment variable disabled",
+			EnvValue:         "false",
+			ExpectedEndpoint: "https://ec2.us-west-2.amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv("AWS_USE_FIPS_ENDPOINT", testCase.EnvValue)
+
+			config := &Config{
+				AccessKey:               "StaticAccessKey",
+				Region:                  endpoints.UsWest2RegionID,
+				SecretKey:               "StaticSecretKey",
+				SkipCredsValidation:     true,
+				SkipGetEC2Platforms:     true,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+				UseFIPSEndpoint:         testCase.UseFIPSEndpoint,
+			}
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := raw.(*AWSClient).EC2Conn().Endpoint, testCase.ExpectedEndpoint; got != expected {
+				t.Errorf("got endpoint %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigClientCustomCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch action := r.FormValue("Action"); action {
+		case "AssumeRole", "AssumeRoleWithWebIdentity":
+			fmt.Fprint(w, testSTSCredentialsResponseBody(action, "AccessKey"))
+		default:
+			fmt.Fprint(w, awsbase.MockStsGetCallerIdentityValidResponseBody)
+		}
+	}))
+	defer server.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca-bundle.pem")
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	if err := os.WriteFile(caBundle, certificate, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidCABundle := filepath.Join(t.TempDir(), "invalid.pem")
+
+	if err := os.WriteFile(invalidCABundle, []byte("invalid"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	// Test cases run in order, so that a trusted bundle leaking into later configurations fails the untrusted cases.
+	testCases := []struct {
+		Name           string
+		AssumeRoleARN  string
+		CustomCABundle string
+		WebIdentity    bool
+		ExpectedError  string
+	}{
+		{
+			Name:           "trusted",
+			CustomCABundle: caBundle,
+		},
+		{
+			Name:          "untrusted",
+			ExpectedError: "certificate",
+		},
+		{
+			Name:           "trusted assume role",
+			AssumeRoleARN:  testAssumeRoleARN,
+			CustomCABundle: caBundle,
+		},
+		{
+			Name:          "untrusted assume role",
+			AssumeRoleARN: testAssumeRoleARN,
+			ExpectedError: "cannot be assumed",
+		},
+		{
+			Name:           "trusted web identity",
+			AssumeRoleARN:  testAssumeRoleARN,
+			CustomCABundle: caBundle,
+			WebIdentity:    true,
+		},
+		{
+			Name:           "missing",
+			CustomCABundle: filepath.Join(t.TempDir(), "missing.pem"),
+			ExpectedError:  "reading custom CA bundle",
+		},
+		{
+			Name:           "invalid",
+			CustomCABundle: invalidCABundle,
+			ExpectedError:  "failed to load custom CA bundle PEM file",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AccessKey:               "accesskey",
+				AssumeRoleARN:           testCase.AssumeRoleARN,
+				CustomCABundle:          testCase.CustomCABundle,
+				Endpoints:               map[string]string{STS: server.URL},
+				Region:                  endpoints.UsWest2RegionID,
+				SecretKey:               "secretkey",
+				SkipGetEC2Platforms:     true,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+			}
+
+			if testCase.WebIdentity {
+				config.AccessKey = ""
+				config.SecretKey = ""
+				config.AssumeRoleWithWebIdentityARN = testWebIdentityRoleARN
+				config.AssumeRoleWithWebIdentityToken = testWebIdentityToken
+			}
+
+			_, err := config.Client()
+
+			if got := os.Getenv("AWS_CA_BUNDLE"); got != "" {
+				t.Errorf("expected AWS_CA_BUNDLE to be unchanged, got %q", got)
+			}
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
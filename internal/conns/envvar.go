@@ -10,6 +10,9 @@ import (
 // Standard AWS environment variables used in the Terraform AWS Provider testing.
 // These are not provided as constants in the AWS Go SDK currently.
 const (
+	// Custom certificate authority bundle (AWS Go SDK does not provide this as constant)
+	EnvVarCABundle = "AWS_CA_BUNDLE"
+
 	// Default static credential identifier for tests (AWS Go SDK does not provide this as constant)
 	// See also AWS_SECRET_ACCESS_KEY and AWS_PROFILE
 	EnvVarAccessKeyId = "AWS_ACCESS_KEY_ID"
@@ -54,6 +57,13 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For recording or replaying the API requests of acceptance tests, either "record" or "replay"
+	EnvVarAccRecordMode = "TF_ACC_RECORD_MODE"
+
+	// The directory of acceptance test cassettes for record and replay modes
+	// Defaults to testdata/cassettes in the directory of the tested package
+	EnvVarAccCassetteDir = "TF_ACC_CASSETTE_DIR"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -72,6 +82,19 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control resource sweepers
+const (
+	// The maximum number of resources deleted concurrently by each sweeper.
+	// Defaults to 10.
+	EnvVarSweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// Whether to run sweepers in dry-run mode, reporting resources instead of deleting them
+	EnvVarSweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// The file to which the dry-run sweep report is written in JSON format
+	EnvVarSweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
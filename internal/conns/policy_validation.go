@@ -0,0 +1,50 @@
+package conns
+
+import (
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+)
+
+// PolicyValidationConfig configures the validation of IAM policy documents
+// with IAM Access Analyzer when planning.
+type PolicyValidationConfig struct {
+	// FailOnFindingTypes are the finding types, e.g. ERROR, which fail the plan.
+	// Findings of other types are logged as warnings.
+	FailOnFindingTypes []string
+
+	// Locale is the language of the finding details. The service default applies if empty.
+	Locale string
+}
+
+// NewPolicyValidationConfig returns a PolicyValidationConfig failing the plan
+// on ERROR and SECURITY_WARNING findings.
+func NewPolicyValidationConfig() *PolicyValidationConfig {
+	return &PolicyValidationConfig{
+		FailOnFindingTypes: []string{
+			accessanalyzer.ValidatePolicyFindingTypeError,
+			accessanalyzer.ValidatePolicyFindingTypeSecurityWarning,
+		},
+	}
+}
+
+func PolicyValidationFindingType_Values() []string {
+	return accessanalyzer.ValidatePolicyFindingType_Values()
+}
+
+func PolicyValidationLocale_Values() []string {
+	return accessanalyzer.Locale_Values()
+}
+
+// FailsOn returns whether findings of the specified type fail the plan.
+func (c *PolicyValidationConfig) FailsOn(findingType string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, v := range c.FailOnFindingTypes {
+		if v == findingType {
+			return true
+		}
+	}
+
+	return false
+}
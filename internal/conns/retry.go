@@ -0,0 +1,397 @@
+package conns
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+const (
+	// RetryBackoffDefault retries with the service client's exponential backoff.
+	RetryBackoffDefault = "default"
+
+	// RetryBackoffThrottle retries with the longer exponential backoff the AWS SDK uses for throttled requests.
+	RetryBackoffThrottle = "throttle"
+
+	// RetryBackoffConstant retries after a fixed delay.
+	RetryBackoffConstant = "constant"
+)
+
+func RetryBackoff_Values() []string {
+	return []string{
+		RetryBackoffDefault,
+		RetryBackoffThrottle,
+		RetryBackoffConstant,
+	}
+}
+
+const (
+	retryBackoffConstantDelay = 5 * time.Second
+)
+
+// RetryRule describes a service API error that is retried.
+type RetryRule struct {
+	// Service is the service key, e.g. EC2.
+	Service string
+
+	// Operations are the API operation names the rule applies to.
+	// A trailing "*" matches any operation name with that prefix.
+	// The rule applies to all operations if empty.
+	Operations []string
+
+	// ErrorCode is the AWS error code to match. Any error code matches if empty.
+	ErrorCode string
+
+	// ErrorMessage is a substring of the AWS error message to match. Any error message matches if empty.
+	ErrorMessage string
+
+	// MaxRetries caps the number of retries for matching errors.
+	// The service client's maximum number of retries applies if zero.
+	MaxRetries int
+
+	// Backoff is the backoff class used between retries. RetryBackoffDefault is used if empty.
+	Backoff string
+}
+
+// matches returns whether the rule applies to the specified failed request.
+func (rule RetryRule) matches(r *request.Request) bool {
+	if len(rule.Operations) > 0 {
+		if r.Operation == nil || !operationNameMatches(r.Operation.Name, rule.Operations) {
+			return false
+		}
+	}
+
+	var awsErr awserr.Error
+
+	if !errors.As(r.Error, &awsErr) {
+		return false
+	}
+
+	if rule.ErrorCode != "" && awsErr.Code() != rule.ErrorCode {
+		return false
+	}
+
+	if rule.ErrorMessage != "" && !strings.Contains(awsErr.Message(), rule.ErrorMessage) {
+		return false
+	}
+
+	return true
+}
+
+// retryer returns the request.Retryer that applies the rule's backoff class.
+func (rule RetryRule) retryer(r *request.Request) request.Retryer {
+	switch rule.Backoff {
+	case RetryBackoffThrottle:
+		return client.DefaultRetryer{
+			NumMaxRetries:    r.MaxRetries(),
+			MinRetryDelay:    client.DefaultRetryerMinThrottleDelay,
+			MinThrottleDelay: client.DefaultRetryerMinThrottleDelay,
+			MaxRetryDelay:    client.DefaultRetryerMaxThrottleDelay,
+			MaxThrottleDelay: client.DefaultRetryerMaxThrottleDelay,
+		}
+	case RetryBackoffConstant:
+		if retryer, ok := r.Retryer.(constantBackoffRetryer); ok {
+			return retryer
+		}
+
+		return constantBackoffRetryer{
+			Retryer: r.Retryer,
+			delay:   retryBackoffConstantDelay,
+		}
+	}
+
+	return r.Retryer
+}
+
+func operationNameMatches(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if name == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
+// constantBackoffRetryer wraps a request.Retryer, replacing its delay between retries with a fixed delay.
+type constantBackoffRetryer struct {
+	request.Retryer
+	delay time.Duration
+}
+
+func (r constantBackoffRetryer) RetryRules(*request.Request) time.Duration {
+	return r.delay
+}
+
+// retryHandler returns a request Retry handler that applies the first matching rule.
+func retryHandler(rules []RetryRule) func(*request.Request) {
+	return func(r *request.Request) {
+		if r.Error == nil {
+			return
+		}
+
+		for _, rule := range rules {
+			if !rule.matches(r) {
+				continue
+			}
+
+			// We only want to retry briefly when a rule caps the number of retries
+			// as the default max retry count would excessively retry when the error could be legitimate.
+			if rule.MaxRetries > 0 && r.RetryCount >= rule.MaxRetries {
+				r.Retryable = aws.Bool(false)
+				return
+			}
+
+			r.Retryable = aws.Bool(true)
+			r.Retryer = rule.retryer(r)
+
+			return
+		}
+	}
+}
+
+// serviceRetryRules returns the retry rules for the specified service key.
+// Rules configured in the provider take precedence over the built-in rules.
+func (client *AWSClient) serviceRetryRules(key string) []RetryRule {
+	if key == s3URICleaningDisabled {
+		key = S3
+	}
+
+	var rules []RetryRule
+
+	for _, ruleSet := range [][]RetryRule{client.retryRules, retryRules} {
+		for _, rule := range ruleSet {
+			if rule.Service == key {
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	return rules
+}
+
+// retryRules contains the built-in retry rules for known eventual consistency and concurrency errors.
+var retryRules = []RetryRule{
+	// Many operations can return an error such as:
+	//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+	// Handle them all globally for the service client.
+	{
+		Service:      APIGateway,
+		ErrorCode:    apigateway.ErrCodeConflictException,
+		ErrorMessage: "try again later",
+	},
+
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	{
+		Service:    AppAutoScaling,
+		Operations: []string{"Describe*", "List*"},
+		ErrorCode:  applicationautoscaling.ErrCodeFailedResourceAccessException,
+	},
+
+	// StartDeployment operations can return a ConflictException
+	// if ongoing deployments are in-progress.
+	{
+		Service:    AppConfig,
+		Operations: []string{"StartDeployment"},
+		ErrorCode:  appconfig.ErrCodeConflictException,
+	},
+
+	{
+		Service:      AppSync,
+		Operations:   []string{"CreateGraphqlApi"},
+		ErrorCode:    appsync.ErrCodeConcurrentModificationException,
+		ErrorMessage: "a GraphQL API creation is already in progress",
+	},
+
+	// When calling CreateVoiceConnector across multiple resources,
+	// the API can randomly return a BadRequestException without explanation.
+	{
+		Service:      Chime,
+		Operations:   []string{"CreateVoiceConnector"},
+		ErrorCode:    chime.ErrCodeBadRequestException,
+		ErrorMessage: "Service received a bad request",
+	},
+
+	{
+		Service:      CloudFormation,
+		ErrorCode:    cloudformation.ErrCodeOperationInProgressException,
+		ErrorMessage: "Another Operation on StackSet",
+	},
+
+	{
+		Service:      CloudHSMV2,
+		ErrorCode:    cloudhsmv2.ErrCodeCloudHsmInternalFailureException,
+		ErrorMessage: "request was rejected because of an AWS CloudHSM internal failure",
+	},
+
+	// When calling Config Organization Rules API actions immediately
+	// after Organization creation, the API can randomly return the
+	// OrganizationAccessDeniedException error for a few minutes, even
+	// after succeeding a few requests.
+	// ~10 retries gives a fair backoff of a few seconds.
+	{
+		Service:      ConfigService,
+		Operations:   []string{"DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule"},
+		ErrorCode:    configservice.ErrCodeOrganizationAccessDeniedException,
+		ErrorMessage: "This action can be only made by AWS Organization's master account.",
+		MaxRetries:   9,
+	},
+	{
+		Service:    ConfigService,
+		Operations: []string{"DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack"},
+		ErrorCode:  configservice.ErrCodeOrganizationAccessDeniedException,
+		MaxRetries: 9,
+	},
+	{
+		Service:    ConfigService,
+		Operations: []string{"DeleteOrganizationConformancePack"},
+		ErrorCode:  configservice.ErrCodeResourceInUseException,
+	},
+
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	{
+		Service:      DynamoDB,
+		Operations:   []string{"PutItem", "UpdateItem", "DeleteItem"},
+		ErrorCode:    dynamodb.ErrCodeLimitExceededException,
+		ErrorMessage: "Subscriber limit exceeded:",
+	},
+
+	{
+		Service:      EC2,
+		Operations:   []string{"CreateClientVpnEndpoint"},
+		ErrorCode:    "OperationNotPermitted",
+		ErrorMessage: "Endpoint cannot be created while another endpoint is being created",
+	},
+	{
+		Service:      EC2,
+		Operations:   []string{"CreateVpnConnection"},
+		ErrorCode:    "VpnConnectionLimitExceeded",
+		ErrorMessage: "maximum number of mutating objects has been reached",
+	},
+	{
+		Service:      EC2,
+		Operations:   []string{"CreateVpnGateway"},
+		ErrorCode:    "VpnGatewayLimitExceeded",
+		ErrorMessage: "maximum number of mutating objects has been reached",
+	},
+	{
+		Service:      EC2,
+		Operations:   []string{"AttachVpnGateway", "DetachVpnGateway"},
+		ErrorCode:    "InvalidParameterValue",
+		ErrorMessage: "This call cannot be completed because there are pending VPNs or Virtual Interfaces",
+	},
+
+	// Acceptance testing creates and deletes resources in quick succession.
+	// The FMS onboarding process into Organizations is opaque to consumers.
+	// Since we cannot reasonably check this status before receiving the error,
+	// set the operation as retryable.
+	{
+		Service:      FMS,
+		Operations:   []string{"AssociateAdminAccount"},
+		ErrorCode:    fms.ErrCodeInvalidOperationException,
+		ErrorMessage: "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.",
+	},
+	{
+		Service:      FMS,
+		Operations:   []string{"DisassociateAdminAccount"},
+		ErrorCode:    fms.ErrCodeInvalidOperationException,
+		ErrorMessage: "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.",
+	},
+
+	{
+		Service:      Kafka,
+		ErrorCode:    kafka.ErrCodeTooManyRequestsException,
+		ErrorMessage: "Too Many Requests",
+	},
+
+	{
+		Service:      Kinesis,
+		Operations:   []string{"CreateStream"},
+		ErrorCode:    kinesis.ErrCodeLimitExceededException,
+		ErrorMessage: "simultaneously be in CREATING or DELETING",
+	},
+	{
+		Service:      Kinesis,
+		Operations:   []string{"CreateStream", "DeleteStream"},
+		ErrorCode:    kinesis.ErrCodeLimitExceededException,
+		ErrorMessage: "Rate exceeded for stream",
+	},
+
+	// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+	{
+		Service:      Organizations,
+		ErrorCode:    organizations.ErrCodeConcurrentModificationException,
+		ErrorMessage: "Try again later",
+	},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+	{
+		Service:    SecurityHub,
+		Operations: []string{"EnableOrganizationAdminAccount"},
+		ErrorCode:  securityhub.ErrCodeResourceConflictException,
+	},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+	{
+		Service:    SSOAdmin,
+		Operations: []string{"AttachManagedPolicyToPermissionSet", "DetachManagedPolicyFromPermissionSet"},
+		ErrorCode:  ssoadmin.ErrCodeConflictException,
+	},
+
+	// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+	{
+		Service:      StorageGateway,
+		ErrorCode:    storagegateway.ErrCodeInvalidGatewayRequestException,
+		ErrorMessage: "The specified gateway proxy network connection is busy",
+	},
+
+	{
+		Service:      WAFV2,
+		ErrorCode:    wafv2.ErrCodeWAFInternalErrorException,
+		ErrorMessage: "Retry your request",
+	},
+	{
+		Service:      WAFV2,
+		ErrorCode:    wafv2.ErrCodeWAFServiceLinkedRoleErrorException,
+		ErrorMessage: "Retry",
+	},
+	// WAFv2 supports tag on create which can result in the below error codes according to the documentation.
+	{
+		Service:      WAFV2,
+		Operations:   []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+		ErrorCode:    wafv2.ErrCodeWAFTagOperationException,
+		ErrorMessage: "Retry your request",
+	},
+	{
+		Service:      WAFV2,
+		Operations:   []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+		ErrorCode:    wafv2.ErrCodeWAFTagOperationInternalErrorException,
+		ErrorMessage: "Retry your request",
+	},
+}
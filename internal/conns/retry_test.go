@@ -0,0 +1,168 @@
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestRetryHandler(t *testing.T) {
+	rules := []RetryRule{
+		{
+			Service:      Organizations,
+			Operations:   []string{"CreateAccount"},
+			ErrorCode:    "ConcurrentModificationException",
+			ErrorMessage: "Try again later",
+		},
+		{
+			Service:    Organizations,
+			Operations: []string{"Describe*"},
+			ErrorCode:  "AccessDeniedException",
+			MaxRetries: 2,
+		},
+		{
+			Service:      Organizations,
+			ErrorMessage: "eventual consistency",
+			Backoff:      RetryBackoffConstant,
+		},
+	}
+
+	testCases := []struct {
+		Name              string
+		Operation         string
+		Error             error
+		RetryCount        int
+		ExpectedRetryable *bool
+	}{
+		{
+			Name:              "no error",
+			Operation:         "CreateAccount",
+			ExpectedRetryable: nil,
+		},
+		{
+			Name:              "non-AWS error",
+			Operation:         "CreateAccount",
+			Error:             errors.New("test"),
+			ExpectedRetryable: nil,
+		},
+		{
+			Name:              "code and message match",
+			Operation:         "CreateAccount",
+			Error:             awserr.New("ConcurrentModificationException", "Conflict. Try again later.", nil),
+			ExpectedRetryable: aws.Bool(true),
+		},
+		{
+			Name:              "code matches message does not",
+			Operation:         "CreateAccount",
+			Error:             awserr.New("ConcurrentModificationException", "Conflict.", nil),
+			ExpectedRetryable: nil,
+		},
+		{
+			Name:              "operation does not match",
+			Operation:         "CloseAccount",
+			Error:             awserr.New("ConcurrentModificationException", "Conflict. Try again later.", nil),
+			ExpectedRetryable: nil,
+		},
+		{
+			Name:              "operation prefix matches",
+			Operation:         "DescribeAccount",
+			Error:             awserr.New("AccessDeniedException", "denied", nil),
+			RetryCount:        1,
+			ExpectedRetryable: aws.Bool(true),
+		},
+		{
+			Name:              "max retries exceeded",
+			Operation:         "DescribeAccount",
+			Error:             awserr.New("AccessDeniedException", "denied", nil),
+			RetryCount:        2,
+			ExpectedRetryable: aws.Bool(false),
+		},
+		{
+			Name:              "message only matches any operation",
+			Operation:         "ListAccounts",
+			Error:             awserr.New("SomethingException", "due to eventual consistency", nil),
+			ExpectedRetryable: aws.Bool(true),
+		},
+	}
+
+	handler := retryHandler(rules)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &request.Request{
+				Error:      testCase.Error,
+				Operation:  &request.Operation{Name: testCase.Operation},
+				RetryCount: testCase.RetryCount,
+				Retryer:    client.DefaultRetryer{NumMaxRetries: 25},
+			}
+
+			handler(r)
+
+			if got, expected := r.Retryable, testCase.ExpectedRetryable; (got == nil) != (expected == nil) || aws.BoolValue(got) != aws.BoolValue(expected) {
+				t.Errorf("got retryable %v, expected %v", aws.BoolValue(got), aws.BoolValue(expected))
+			}
+		})
+	}
+}
+
+func TestRetryRuleRetryer(t *testing.T) {
+	r := &request.Request{
+		Retryer: client.DefaultRetryer{NumMaxRetries: 10},
+	}
+
+	retryer := RetryRule{Backoff: RetryBackoffConstant}.retryer(r)
+
+	if got, expected := retryer.RetryRules(r), retryBackoffConstantDelay; got != expected {
+		t.Errorf("got constant backoff delay %s, expected %s", got, expected)
+	}
+
+	if got, expected := retryer.MaxRetries(), 10; got != expected {
+		t.Errorf("got constant backoff max retries %d, expected %d", got, expected)
+	}
+
+	retryer = RetryRule{Backoff: RetryBackoffThrottle}.retryer(r)
+
+	if got, minimum := retryer.RetryRules(r), client.DefaultRetryerMinThrottleDelay; got < minimum {
+		t.Errorf("got throttle backoff delay %s, expected at least %s", got, minimum)
+	}
+
+	if got, expected := retryer.MaxRetries(), 10; got != expected {
+		t.Errorf("got throttle backoff max retries %d, expected %d", got, expected)
+	}
+
+	retryer = RetryRule{}.retryer(r)
+
+	if got, maximum := retryer.RetryRules(r), 100*time.Millisecond; got > maximum {
+		t.Errorf("got default backoff delay %s, expected at most %s", got, maximum)
+	}
+}
+
+func TestAWSClientServiceRetryRules(t *testing.T) {
+	awsClient := &AWSClient{
+		retryRules: []RetryRule{
+			{Service: Organizations, ErrorCode: "TestException"},
+			{Service: EC2, ErrorCode: "TestException"},
+		},
+	}
+
+	rules := awsClient.serviceRetryRules(Organizations)
+
+	if len(rules) < 2 {
+		t.Fatalf("expected configured and built-in Organizations retry rules, got %d", len(rules))
+	}
+
+	if got, expected := rules[0].ErrorCode, "TestException"; got != expected {
+		t.Errorf("got first rule error code %s, expected configured rule %s", got, expected)
+	}
+
+	for _, rule := range rules {
+		if rule.Service != Organizations {
+			t.Errorf("got rule for service %s, expected only %s", rule.Service, Organizations)
+		}
+	}
+}
@@ -0,0 +1,426 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	testWebIdentityRoleARN     = "arn:aws:iam::123456789012:role/WebIdentity"
+	testWebIdentityAccessKey   = "WebIdentityAccessKey"
+	testWebIdentityToken       = "WebIdentityToken"
+	testAssumeRoleARN          = "arn:aws:iam::555555555555:role/AssumeRole"
+	testAssumeRoleAccessKey    = "AssumeRoleAccessKey"
+	testAssumeRolePolicyARN    = "arn:aws:iam::123456789012:policy/WebIdentityPolicy"
+	testWebIdentitySessionName = "WebIdentitySessionName"
+	testSTSExpiredExpiration   = "2000-01-01T00:00:00Z"
+)
+
+// testSTSServer is a local STS stub recording the requests it receives.
+type testSTSServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	requests []testSTSRequest
+
+	// expiredCredentials makes every response return already expired credentials, with a new
+	// AssumeRoleWithWebIdentity access key each time, so that they are refreshed whenever they are used.
+	expiredCredentials  bool
+	webIdentityRequests int
+}
+
+type testSTSRequest struct {
+	AccessKey string
+	Params    map[string]string
+}
+
+func newTestSTSServer(t *testing.T) *testSTSServer {
+	s := &testSTSServer{}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		request := testSTSRequest{
+			Params: make(map[string]string),
+		}
+
+		for k := range r.PostForm {
+			request.Params[k] = r.PostForm.Get(k)
+		}
+
+		// e.g. "AWS4-HMAC-SHA256 Credential=AccessKey/20211101/us-east-1/sts/aws4_request, ..."
+		if v := r.Header.Get("Authorization"); v != "" {
+			if i := strings.Index(v, "Credential="); i >= 0 {
+				request.AccessKey = strings.SplitN(v[i+len("Credential="):], "/", 2)[0]
+			}
+		}
+
+		s.lock.Lock()
+		s.requests = append(s.requests, request)
+		expiredCredentials := s.expiredCredentials
+		if request.Params["Action"] == "AssumeRoleWithWebIdentity" {
+			s.webIdentityRequests++
+		}
+		webIdentityRequests := s.webIdentityRequests
+		s.lock.Unlock()
+
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch action := request.Params["Action"]; action {
+		case "AssumeRoleWithWebIdentity":
+			if request.Params["WebIdentityToken"] != testWebIdentityToken {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, testSTSErrorResponseBody("AccessDenied", "Not authorized to perform sts:AssumeRoleWithWebIdentity"))
+				return
+			}
+
+			if expiredCredentials {
+				fmt.Fprint(w, testSTSCredentialsResponseBodyWithExpiration(action, fmt.Sprintf("%s%d", testWebIdentityAccessKey, webIdentityRequests), testSTSExpiredExpiration))
+				return
+			}
+
+			fmt.Fprint(w, testSTSCredentialsResponseBody(action, testWebIdentityAccessKey))
+		case "AssumeRole":
+			if expiredCredentials {
+				fmt.Fprint(w, testSTSCredentialsResponseBodyWithExpiration(action, testAssumeRoleAccessKey, testSTSExpiredExpiration))
+				return
+			}
+
+			fmt.Fprint(w, testSTSCredentialsResponseBody(action, testAssumeRoleAccessKey))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, testSTSErrorResponseBody("InvalidAction", action))
+		}
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *testSTSServer) Requests() []testSTSRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.requests
+}
+
+func testSTSCredentialsResponseBody(action, accessKey string) string {
+	return testSTSCredentialsResponseBodyWithExpiration(action, accessKey, "2099-12-31T23:59:59Z")
+}
+
+func testSTSCredentialsResponseBodyWithExpiration(action, accessKey, expiration string) string {
+	return fmt.Sprintf(`<%[1]sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<%[1]sResult>
+  <Credentials>
+    <AccessKeyId>%[2]s</AccessKeyId>
+    <SecretAccessKey>SecretKey</SecretAccessKey>
+    <SessionToken>SessionToken</SessionToken>
+    <Expiration>%[3]s</Expiration>
+  </Credentials>
+</%[1]sResult>
+<ResponseMetadata>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ResponseMetadata>
+</%[1]sResponse>`, action, accessKey, expiration)
+}
+
+func testSTSErrorResponseBody(code, message string) string {
+	return fmt.Sprintf(`<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<Error>
+  <Type>Sender</Type>
+  <Code>%s</Code>
+  <Message>%s</Message>
+</Error>
+<RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`, code, message)
+}
+
+func TestConfigWebIdentityCredentials(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := os.WriteFile(tokenFile, []byte(testWebIdentityToken), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name           string
+		Config         *Config
+		ExpectedParams map[string]string
+		ExpectedError  string
+	}{
+		{
+			Name: "inline token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         testWebIdentityRoleARN,
+				AssumeRoleWithWebIdentitySessionName: testWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       testWebIdentityToken,
+			},
+			ExpectedParams: map[string]string{
+				"Action":           "AssumeRoleWithWebIdentity",
+				"RoleArn":          testWebIdentityRoleARN,
+				"RoleSessionName":  testWebIdentitySessionName,
+				"Version":          "2011-06-15",
+				"WebIdentityToken": testWebIdentityToken,
+			},
+		},
+		{
+			Name: "token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         testWebIdentityRoleARN,
+				AssumeRoleWithWebIdentitySessionName: testWebIdentitySessionName,
+				AssumeRoleWithWebIdentityTokenFile:   tokenFile,
+			},
+			ExpectedParams: map[string]string{
+				"Action":           "AssumeRoleWithWebIdentity",
+				"RoleArn":          testWebIdentityRoleARN,
+				"RoleSessionName":  testWebIdentitySessionName,
+				"Version":          "2011-06-15",
+				"WebIdentityToken": testWebIdentityToken,
+			},
+		},
+		{
+			Name: "duration and policy ARNs",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:             testWebIdentityRoleARN,
+				AssumeRoleWithWebIdentityDurationSeconds: 3600,
+				AssumeRoleWithWebIdentityPolicyARNs:      []string{testAssumeRolePolicyARN},
+				AssumeRoleWithWebIdentitySessionName:     testWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:           testWebIdentityToken,
+			},
+			ExpectedParams: map[string]string{
+				"Action":                  "AssumeRoleWithWebIdentity",
+				"DurationSeconds":         "3600",
+				"PolicyArns.member.1.arn": testAssumeRolePolicyARN,
+				"RoleArn":                 testWebIdentityRoleARN,
+				"RoleSessionName":         testWebIdentitySessionName,
+				"Version":                 "2011-06-15",
+				"WebIdentityToken":        testWebIdentityToken,
+			},
+		},
+		{
+			Name: "invalid token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:   testWebIdentityRoleARN,
+				AssumeRoleWithWebIdentityToken: "invalid",
+			},
+			ExpectedError: "AccessDenied",
+		},
+		{
+			Name: "missing token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN: testWebIdentityRoleARN,
+			},
+			ExpectedError: "one of WebIdentityToken, WebIdentityTokenFile must be set",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			server := newTestSTSServer(t)
+
+			testCase.Config.Endpoints = map[string]string{STS: server.URL}
+			testCase.Config.Region = "us-east-1"
+			testCase.Config.SkipCredsValidation = true
+			testCase.Config.SkipGetEC2Platforms = true
+			testCase.Config.SkipMetadataApiCheck = true
+			testCase.Config.SkipRequestingAccountId = true
+
+			raw, err := testCase.Config.Client()
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			value, err := raw.(*AWSClient).session.Config.Credentials.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error getting credentials: %s", err)
+			}
+
+			if got, expected := value.AccessKeyID, testWebIdentityAccessKey; got != expected {
+				t.Errorf("got access key %q, expected %q", got, expected)
+			}
+
+			requests := server.Requests()
+
+			if len(requests) == 0 {
+				t.Fatal("expected STS requests, got none")
+			}
+
+			for _, request := range requests {
+				if got := request.AccessKey; got != "" {
+					t.Errorf("got request signed with access key %q, expected unsigned request", got)
+				}
+
+				for k, expected := range testCase.ExpectedParams {
+					if got := request.Params[k]; got != expected {
+						t.Errorf("got %s %q, expected %q", k, got, expected)
+					}
+				}
+
+				if got, expected := len(request.Params), len(testCase.ExpectedParams); got != expected {
+					t.Errorf("got %d request parameters (%v), expected %d", got, request.Params, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigClientWebIdentity(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		AssumeRoleARN      string
+		ExpectedAccessKeys map[string]string // STS action to the access key its requests are signed with
+		ExpectedAccessKey  string
+	}{
+		{
+			Name: "web identity",
+			ExpectedAccessKeys: map[string]string{
+				"AssumeRoleWithWebIdentity": "",
+			},
+			ExpectedAccessKey: testWebIdentityAccessKey,
+		},
+		{
+			Name:          "chained assume role",
+			AssumeRoleARN: testAssumeRoleARN,
+			ExpectedAccessKeys: map[string]string{
+				"AssumeRoleWithWebIdentity": "",
+				"AssumeRole":                testWebIdentityAccessKey,
+			},
+			ExpectedAccessKey: testAssumeRoleAccessKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			server := newTestSTSServer(t)
+
+			config := &Config{
+				AssumeRoleARN:                  testCase.AssumeRoleARN,
+				AssumeRoleWithWebIdentityARN:   testWebIdentityRoleARN,
+				AssumeRoleWithWebIdentityToken: testWebIdentityToken,
+				Endpoints:                      map[string]string{STS: server.URL},
+				Region:                         "us-east-1",
+				SkipCredsValidation:            true,
+				SkipGetEC2Platforms:            true,
+				SkipMetadataApiCheck:           true,
+				SkipRequestingAccountId:        true,
+			}
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			value, err := raw.(*AWSClient).session.Config.Credentials.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error getting credentials: %s", err)
+			}
+
+			if got, expected := value.AccessKeyID, testCase.ExpectedAccessKey; got != expected {
+				t.Errorf("got access key %q, expected %q", got, expected)
+			}
+
+			actions := make(map[string]bool)
+
+			for _, request := range server.Requests() {
+				action := request.Params["Action"]
+				actions[action] = true
+
+				expected, ok := testCase.ExpectedAccessKeys[action]
+
+				if !ok {
+					t.Errorf("got unexpected STS request action %q", action)
+					continue
+				}
+
+				if got := request.AccessKey; got != expected {
+					t.Errorf("got %s request signed with access key %q, expected %q", action, got, expected)
+				}
+			}
+
+			for action := range testCase.ExpectedAccessKeys {
+				if !actions[action] {
+					t.Errorf("expected STS request action %q", action)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigClientWebIdentityChainedRefresh(t *testing.T) {
+	server := newTestSTSServer(t)
+	server.expiredCredentials = true
+
+	config := &Config{
+		AssumeRoleARN:                  testAssumeRoleARN,
+		AssumeRoleWithWebIdentityARN:   testWebIdentityRoleARN,
+		AssumeRoleWithWebIdentityToken: testWebIdentityToken,
+		Endpoints:                      map[string]string{STS: server.URL},
+		Region:                         "us-east-1",
+		SkipCredsValidation:            true,
+		SkipGetEC2Platforms:            true,
+		SkipMetadataApiCheck:           true,
+		SkipRequestingAccountId:        true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The assumed role's credentials are already expired, as when a long apply outlasts the role session.
+	value, err := raw.(*AWSClient).session.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error refreshing credentials: %s", err)
+	}
+
+	if got, expected := value.AccessKeyID, testAssumeRoleAccessKey; got != expected {
+		t.Errorf("got access key %q, expected %q", got, expected)
+	}
+
+	var assumeRoleAccessKeys []string
+
+	for _, request := range server.Requests() {
+		if request.Params["Action"] == "AssumeRole" {
+			assumeRoleAccessKeys = append(assumeRoleAccessKeys, request.AccessKey)
+		}
+	}
+
+	if got, expected := len(assumeRoleAccessKeys), 2; got < expected {
+		t.Fatalf("got %d AssumeRole requests, expected at least %d", got, expected)
+	}
+
+	for _, accessKey := range assumeRoleAccessKeys {
+		if !strings.HasPrefix(accessKey, testWebIdentityAccessKey) {
+			t.Errorf("got AssumeRole request signed with access key %q, expected web identity credentials", accessKey)
+		}
+	}
+
+	// Each AssumeRole request must be signed with web identity credentials refreshed for it, not a snapshot.
+	if assumeRoleAccessKeys[len(assumeRoleAccessKeys)-2] == assumeRoleAccessKeys[len(assumeRoleAccessKeys)-1] {
+		t.Errorf("expected AssumeRole requests to be signed with refreshed web identity credentials, got %v", assumeRoleAccessKeys)
+	}
+}
@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -50,6 +52,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
@@ -100,11 +103,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
@@ -133,6 +138,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
@@ -188,6 +194,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -220,6 +228,8 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry": retrySchema(),
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -259,6 +269,12 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["custom_ca_bundle"],
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -286,6 +302,17 @@ func Provider() *schema.Provider {
 				},
 			},
 
+			"tag_policy": tagPolicySchema(),
+
+			"policy_validation": policyValidationSchema(),
+
+			"ec2_describe_batching": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["ec2_describe_batching"],
+			},
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -334,9 +361,25 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"aws_accessanalyzer_policy_validation": accessanalyzer.DataSourcePolicyValidation(),
+
 			"aws_acm_certificate": acm.DataSourceCertificate(),
 
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
@@ -365,7 +408,8 @@ func Provider() *schema.Provider {
 			"aws_batch_compute_environment": batch.DataSourceComputeEnvironment(),
 			"aws_batch_job_queue":           batch.DataSourceJobQueue(),
 
-			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resource":  cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resources": cloudcontrol.DataSourceResources(),
 
 			"aws_cloudformation_export": cloudformation.DataSourceExport(),
 			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
@@ -397,8 +441,16 @@ func Provider() *schema.Provider {
 
 			"aws_cognito_user_pools": cognitoidp.DataSourceUserPools(),
 
-			"aws_connect_contact_flow": connect.DataSourceContactFlow(),
-			"aws_connect_instance":     connect.DataSourceInstance(),
+			"aws_connect_bot_association":             connect.DataSourceBotAssociation(),
+			"aws_connect_contact_flow":                connect.DataSourceContactFlow(),
+			"aws_connect_hours_of_operation":          connect.DataSourceHoursOfOperation(),
+			"aws_connect_instance":                    connect.DataSourceInstance(),
+			"aws_connect_lambda_function_association": connect.DataSourceLambdaFunctionAssociation(),
+			"aws_connect_queue":                       connect.DataSourceQueue(),
+			"aws_connect_quick_connect":               connect.DataSourceQuickConnect(),
+			"aws_connect_routing_profile":             connect.DataSourceRoutingProfile(),
+			"aws_connect_security_profile":            connect.DataSourceSecurityProfile(),
+			"aws_connect_user":                        connect.DataSourceUser(),
 
 			"aws_cur_report_definition": cur.DataSourceReportDefinition(),
 
@@ -533,6 +585,7 @@ func Provider() *schema.Provider {
 			"aws_iam_instance_profile":   iam.DataSourceInstanceProfile(),
 			"aws_iam_policy":             iam.DataSourcePolicy(),
 			"aws_iam_policy_document":    iam.DataSourcePolicyDocument(),
+			"aws_iam_policy_simulation":  iam.DataSourcePolicySimulation(),
 			"aws_iam_role":               iam.DataSourceRole(),
 			"aws_iam_roles":              iam.DataSourceRoles(),
 			"aws_iam_server_certificate": iam.DataSourceServerCertificate(),
@@ -585,6 +638,13 @@ func Provider() *schema.Provider {
 			"aws_lex_intent":    lexmodels.DataSourceIntent(),
 			"aws_lex_slot_type": lexmodels.DataSourceSlotType(),
 
+			"aws_memorydb_acl":             memorydb.DataSourceACL(),
+			"aws_memorydb_cluster":         memorydb.DataSourceCluster(),
+			"aws_memorydb_parameter_group": memorydb.DataSourceParameterGroup(),
+			"aws_memorydb_snapshot":        memorydb.DataSourceSnapshot(),
+			"aws_memorydb_subnet_group":    memorydb.DataSourceSubnetGroup(),
+			"aws_memorydb_user":            memorydb.DataSourceUser(),
+
 			"aws_arn":                     meta.DataSourceARN(),
 			"aws_billing_service_account": meta.DataSourceBillingServiceAccount(),
 			"aws_default_tags":            meta.DataSourceDefaultTags(),
@@ -598,6 +658,20 @@ func Provider() *schema.Provider {
 			"aws_neptune_engine_version":        neptune.DataSourceEngineVersion(),
 			"aws_neptune_orderable_db_instance": neptune.DataSourceOrderableDBInstance(),
 
+			"aws_networkmanager_connection":                   networkmanager.DataSourceConnection(),
+			"aws_networkmanager_connections":                  networkmanager.DataSourceConnections(),
+			"aws_networkmanager_customer_gateway_association": networkmanager.DataSourceCustomerGatewayAssociation(),
+			"aws_networkmanager_device":                       networkmanager.DataSourceDevice(),
+			"aws_networkmanager_devices":                      networkmanager.DataSourceDevices(),
+			"aws_networkmanager_global_network":               networkmanager.DataSourceGlobalNetwork(),
+			"aws_networkmanager_global_networks":              networkmanager.DataSourceGlobalNetworks(),
+			"aws_networkmanager_link":                         networkmanager.DataSourceLink(),
+			"aws_networkmanager_link_association":             networkmanager.DataSourceLinkAssociation(),
+			"aws_networkmanager_links":                        networkmanager.DataSourceLinks(),
+			"aws_networkmanager_site":                         networkmanager.DataSourceSite(),
+			"aws_networkmanager_sites":                        networkmanager.DataSourceSites(),
+			"aws_networkmanager_transit_gateway_registration": networkmanager.DataSourceTransitGatewayRegistration(),
+
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
 			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
 			"aws_organizations_organization":             organizations.DataSourceOrganization(),
@@ -634,6 +708,7 @@ func Provider() *schema.Provider {
 			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
 
 			"aws_route53_delegation_set": route53.DataSourceDelegationSet(),
+			"aws_route53_records":        route53.DataSourceRecords(),
 			"aws_route53_zone":           route53.DataSourceZone(),
 
 			"aws_route53_resolver_endpoint": route53resolver.DataSourceEndpoint(),
@@ -710,7 +785,8 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_analyzer":     accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_archive_rule": accessanalyzer.ResourceArchiveRule(),
 
 			"aws_acm_certificate":            acm.ResourceCertificate(),
 			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
@@ -938,8 +1014,16 @@ func Provider() *schema.Provider {
 			"aws_config_organization_managed_rule":     configservice.ResourceOrganizationManagedRule(),
 			"aws_config_remediation_configuration":     configservice.ResourceRemediationConfiguration(),
 
-			"aws_connect_contact_flow": connect.ResourceContactFlow(),
-			"aws_connect_instance":     connect.ResourceInstance(),
+			"aws_connect_bot_association":             connect.ResourceBotAssociation(),
+			"aws_connect_contact_flow":                connect.ResourceContactFlow(),
+			"aws_connect_hours_of_operation":          connect.ResourceHoursOfOperation(),
+			"aws_connect_instance":                    connect.ResourceInstance(),
+			"aws_connect_lambda_function_association": connect.ResourceLambdaFunctionAssociation(),
+			"aws_connect_queue":                       connect.ResourceQueue(),
+			"aws_connect_quick_connect":               connect.ResourceQuickConnect(),
+			"aws_connect_routing_profile":             connect.ResourceRoutingProfile(),
+			"aws_connect_security_profile":            connect.ResourceSecurityProfile(),
+			"aws_connect_user":                        connect.ResourceUser(),
 
 			"aws_cur_report_definition": cur.ResourceReportDefinition(),
 
@@ -957,6 +1041,11 @@ func Provider() *schema.Provider {
 			"aws_dax_parameter_group": dax.ResourceParameterGroup(),
 			"aws_dax_subnet_group":    dax.ResourceSubnetGroup(),
 
+			"aws_detective_graph":                      detective.ResourceGraph(),
+			"aws_detective_invitation_accepter":        detective.ResourceInvitationAccepter(),
+			"aws_detective_member":                     detective.ResourceMember(),
+			"aws_detective_organization_admin_account": detective.ResourceOrganizationAdminAccount(),
+
 			"aws_devicefarm_project": devicefarm.ResourceProject(),
 
 			"aws_dx_bgp_peer":                                  directconnect.ResourceBGPPeer(),
@@ -1034,6 +1123,8 @@ func Provider() *schema.Provider {
 			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
 			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_analysis":                   ec2.ResourceNetworkInsightsAnalysis(),
+			"aws_ec2_network_insights_path":                       ec2.ResourceNetworkInsightsPath(),
 			"aws_ec2_tag":                                         ec2.ResourceTag(),
 			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
 			"aws_ec2_traffic_mirror_filter_rule":                  ec2.ResourceTrafficMirrorFilterRule(),
@@ -1085,10 +1176,17 @@ func Provider() *schema.Provider {
 			"aws_vpc_endpoint_service":                            ec2.ResourceVPCEndpointService(),
 			"aws_vpc_endpoint_service_allowed_principal":          ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
 			"aws_vpc_endpoint_subnet_association":                 ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_ipam":                                        ec2.ResourceVPCIPAM(),
+			"aws_vpc_ipam_pool":                                   ec2.ResourceVPCIPAMPool(),
+			"aws_vpc_ipam_pool_cidr":                              ec2.ResourceVPCIPAMPoolCIDR(),
+			"aws_vpc_ipam_pool_cidr_allocation":                   ec2.ResourceVPCIPAMPoolCIDRAllocation(),
+			"aws_vpc_ipam_scope":                                  ec2.ResourceVPCIPAMScope(),
 			"aws_vpc_ipv4_cidr_block_association":                 ec2.ResourceVPCIPv4CIDRBlockAssociation(),
 			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_egress_rule":                  ec2.ResourceVPCSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                 ec2.ResourceVPCSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                  ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                     ec2.ResourceVPNGateway(),
@@ -1297,6 +1395,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
 			"aws_lambda_function":                       lambda.ResourceFunction(),
 			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_invocation":                     lambda.ResourceInvocation(),
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_permission":                     lambda.ResourcePermission(),
 			"aws_lambda_provisioned_concurrency_config": lambda.ResourceProvisionedConcurrencyConfig(),
@@ -1334,6 +1433,13 @@ func Provider() *schema.Provider {
 			"aws_media_store_container":        mediastore.ResourceContainer(),
 			"aws_media_store_container_policy": mediastore.ResourceContainerPolicy(),
 
+			"aws_memorydb_acl":             memorydb.ResourceACL(),
+			"aws_memorydb_cluster":         memorydb.ResourceCluster(),
+			"aws_memorydb_parameter_group": memorydb.ResourceParameterGroup(),
+			"aws_memorydb_snapshot":        memorydb.ResourceSnapshot(),
+			"aws_memorydb_subnet_group":    memorydb.ResourceSubnetGroup(),
+			"aws_memorydb_user":            memorydb.ResourceUser(),
+
 			"aws_mq_broker":        mq.ResourceBroker(),
 			"aws_mq_configuration": mq.ResourceConfiguration(),
 
@@ -1354,6 +1460,15 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(),
 			"aws_networkfirewall_rule_group":            networkfirewall.ResourceRuleGroup(),
 
+			"aws_networkmanager_connection":                   networkmanager.ResourceConnection(),
+			"aws_networkmanager_customer_gateway_association": networkmanager.ResourceCustomerGatewayAssociation(),
+			"aws_networkmanager_device":                       networkmanager.ResourceDevice(),
+			"aws_networkmanager_global_network":               networkmanager.ResourceGlobalNetwork(),
+			"aws_networkmanager_link":                         networkmanager.ResourceLink(),
+			"aws_networkmanager_link_association":             networkmanager.ResourceLinkAssociation(),
+			"aws_networkmanager_site":                         networkmanager.ResourceSite(),
+			"aws_networkmanager_transit_gateway_registration": networkmanager.ResourceTransitGatewayRegistration(),
+
 			"aws_opsworks_application":      opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":     opsworks.ResourceCustomLayer(),
 			"aws_opsworks_ganglia_layer":    opsworks.ResourceGangliaLayer(),
@@ -1466,17 +1581,29 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
 			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
 
-			"aws_s3_bucket":                                   s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration":           s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
-			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
-			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
-			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
-			"aws_s3_bucket_object":                            s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":                s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_policy":                            s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":               s3.ResourceBucketPublicAccessBlock(),
-			"aws_s3_object_copy":                              s3.ResourceObjectCopy(),
+			"aws_s3_bucket":                                      s3.ResourceBucket(),
+			"aws_s3_bucket_accelerate_configuration":             s3.ResourceBucketAccelerateConfiguration(),
+			"aws_s3_bucket_acl":                                  s3.ResourceBucketACL(),
+			"aws_s3_bucket_analytics_configuration":              s3.ResourceBucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                   s3.ResourceBucketCorsConfiguration(),
+			"aws_s3_bucket_intelligent_tiering_configuration":    s3.ResourceBucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_inventory":                            s3.ResourceBucketInventory(),
+			"aws_s3_bucket_lifecycle_configuration":              s3.ResourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),
+			"aws_s3_bucket_metric":                               s3.ResourceBucketMetric(),
+			"aws_s3_bucket_notification":                         s3.ResourceBucketNotification(),
+			"aws_s3_bucket_object":                               s3.ResourceBucketObject(),
+			"aws_s3_bucket_object_lock_configuration":            s3.ResourceBucketObjectLockConfiguration(),
+			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),
+			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),
+			"aws_s3_bucket_public_access_block":                  s3.ResourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_replication_configuration":            s3.ResourceBucketReplicationConfiguration(),
+			"aws_s3_bucket_request_payment_configuration":        s3.ResourceBucketRequestPaymentConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
+			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_sync":                              s3.ResourceDirectorySync(),
+			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 
 			"aws_s3_access_point":                          s3control.ResourceAccessPoint(),
 			"aws_s3_account_public_access_block":           s3control.ResourceAccountPublicAccessBlock(),
@@ -1567,6 +1694,13 @@ func Provider() *schema.Provider {
 			"aws_ses_receipt_rule_set":             ses.ResourceReceiptRuleSet(),
 			"aws_ses_template":                     ses.ResourceTemplate(),
 
+			"aws_sesv2_configuration_set":                   sesv2.ResourceConfigurationSet(),
+			"aws_sesv2_configuration_set_event_destination": sesv2.ResourceConfigurationSetEventDestination(),
+			"aws_sesv2_contact_list":                        sesv2.ResourceContactList(),
+			"aws_sesv2_dedicated_ip_pool":                   sesv2.ResourceDedicatedIPPool(),
+			"aws_sesv2_email_identity":                      sesv2.ResourceEmailIdentity(),
+			"aws_sesv2_email_identity_feedback_attributes":  sesv2.ResourceEmailIdentityFeedbackAttributes(),
+
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
@@ -1674,17 +1808,41 @@ func Provider() *schema.Provider {
 		},
 	}
 
-	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+	// Tagged resources validate their tags against the provider-level tag policy
+	// in their CustomizeDiff, which requires the resource type.
+	for typeName, r := range provider.ResourcesMap {
+		if _, ok := r.Schema["tags_all"]; !ok || r.CustomizeDiff == nil {
+			continue
+		}
+
+		r.CustomizeDiff = customizeDiffWithResourceType(typeName, r.CustomizeDiff)
+	}
+
+	provider.ConfigureFunc = configureFunc(provider, nil)
+
+	return provider
+}
+
+// ProviderWithConfigHook returns a *schema.Provider that calls hook with the
+// AWS client configuration of every instance before the client is created.
+// It allows acceptance testing to record and replay API requests.
+func ProviderWithConfigHook(hook func(*conns.Config)) *schema.Provider {
+	provider := Provider()
+	provider.ConfigureFunc = configureFunc(provider, hook)
+
+	return provider
+}
+
+func configureFunc(provider *schema.Provider, hook func(*conns.Config)) schema.ConfigureFunc {
+	return func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
 			// Terraform 0.12 introduced this field to the protocol
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(d, terraformVersion)
+		return providerConfigure(d, terraformVersion, hook)
 	}
-
-	return provider
 }
 
 var descriptions map[string]string
@@ -1716,8 +1874,15 @@ func init() {
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"custom_ca_bundle": "The path to a file containing PEM-encoded certificates of certificate authorities " +
+			"to trust instead of the system ones, e.g. for an HTTPS-inspecting proxy. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"ec2_describe_batching": "Coalesce the EC2 instance, security group and subnet lookups by ID of concurrent refreshes " +
+			"into Describe calls with many IDs, e.g. to reduce API throttling when refreshing many resources.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 			"default value is `false`",
 
@@ -1740,10 +1905,16 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability. " +
+			"Can also be configured using the `AWS_USE_DUALSTACK_ENDPOINT` environment variable.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability. " +
+			"Can also be configured using the `AWS_USE_FIPS_ENDPOINT` environment variable.",
 	}
 }
 
-func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, terraformVersion string, hook func(*conns.Config)) (interface{}, error) {
 	config := conns.Config{
 		AccessKey:               d.Get("access_key").(string),
 		SecretKey:               d.Get("secret_key").(string),
@@ -1757,12 +1928,15 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		HTTPProxy:               d.Get("http_proxy").(string),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
+		UseDualStackEndpoint:    d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:         d.Get("use_fips_endpoint").(bool),
 		TerraformVersion:        terraformVersion,
 	}
 
@@ -1830,6 +2004,61 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
+		}
+
+		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+			for _, policyARNRaw := range policyARNSet.List() {
+				policyARN, ok := policyARNRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, policyARN)
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentitySessionName = v
+		}
+
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
+		}
+
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityARN, config.AssumeRoleWithWebIdentitySessionName)
+	}
+
+	retryRules, err := expandProviderRetryRules(d.Get("retry").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.RetryRules = retryRules
+
+	tagPolicyConfig, err := expandProviderTagPolicy(d.Get("tag_policy").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.TagPolicyConfig = tagPolicyConfig
+	config.PolicyValidationConfig = expandProviderPolicyValidation(d.Get("policy_validation").([]interface{}))
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1860,7 +2089,21 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
-	return config.Client()
+	if hook != nil {
+		hook(&config)
+	}
+
+	client, err := config.Client()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Get("ec2_describe_batching").(bool) {
+		ec2.EnableDescribeBatching(client.(*conns.AWSClient).EC2Conn())
+	}
+
+	return client, nil
 }
 
 func assumeRoleSchema() *schema.Schema {
@@ -1923,6 +2166,57 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Seconds to restrict the assume role session duration.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume with a web identity token prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "File containing an OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1944,6 +2238,53 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration block with additional rules for retrying failed AWS API requests.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      conns.RetryBackoffDefault,
+					Description:  "Backoff class used between retries.",
+					ValidateFunc: validation.StringInSlice(conns.RetryBackoff_Values(), false),
+				},
+				"error_code": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "AWS error code to retry.",
+				},
+				"error_message": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Substring of the AWS error message to retry.",
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Maximum number of retries for matching errors.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"operations": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "API operation names to retry. A trailing `*` matches any operation name with that prefix.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Service the rule applies to, using the same keys as the endpoints configuration block.",
+					ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+				},
+			},
+		},
+	}
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1976,3 +2317,219 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 
 	return ignoreConfig
 }
+
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with tag rules enforced across all resources when planning.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rule": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: "Tag rules, each for a single tag key.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Tag key the rule applies to, matched case-sensitively.",
+							},
+							"required": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Whether resources must have the tag.",
+							},
+							"resource_types": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Resource types the rule is limited to. Defaults to all tagged resources.",
+							},
+							"value_case": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Case of the tag value, either lower or upper.",
+								ValidateFunc: validation.StringInSlice(tftags.PolicyCases(), false),
+							},
+							"value_pattern": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Regular expression the tag value must match.",
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffWithResourceType returns a CustomizeDiff function calling f with a context
+// carrying the resource type, for determining the tag policy rules which apply to the resource.
+func customizeDiffWithResourceType(typeName string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		return f(tftags.NewResourceTypeContext(ctx, typeName), diff, meta)
+	}
+}
+
+func expandProviderTagPolicy(l []interface{}) (*tftags.PolicyConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+	m := l[0].(map[string]interface{})
+
+	for _, tfMapRaw := range m["rule"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := tftags.PolicyRule{
+			Key:      tfMap["key"].(string),
+			Required: tfMap["required"].(bool),
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			for _, resourceTypeRaw := range v.List() {
+				rule.ResourceTypes = append(rule.ResourceTypes, resourceTypeRaw.(string))
+			}
+		}
+
+		if v, ok := tfMap["value_case"].(string); ok && v != "" {
+			rule.ValueCase = v
+		}
+
+		if v, ok := tfMap["value_pattern"].(string); ok && v != "" {
+			re, err := regexp.Compile(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to configure tag policy rule (%s): %w", rule.Key, err)
+			}
+
+			rule.ValuePattern = re
+		}
+
+		policyConfig.Rules = append(policyConfig.Rules, rule)
+	}
+
+	return policyConfig, nil
+}
+
+func policyValidationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block enabling validation of IAM policy documents with IAM Access Analyzer when planning.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fail_on_finding_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(conns.PolicyValidationFindingType_Values(), false),
+					},
+					Description: "Finding types which fail the plan. Defaults to ERROR and SECURITY_WARNING.",
+				},
+				"locale": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Language of the finding details.",
+					ValidateFunc: validation.StringInSlice(conns.PolicyValidationLocale_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandProviderPolicyValidation(l []interface{}) *conns.PolicyValidationConfig {
+	if len(l) == 0 {
+		return nil
+	}
+
+	policyValidationConfig := conns.NewPolicyValidationConfig()
+
+	// An empty configuration block enables validation with the defaults.
+	m, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return policyValidationConfig
+	}
+
+	if v, ok := m["fail_on_finding_types"].(*schema.Set); ok && v.Len() > 0 {
+		policyValidationConfig.FailOnFindingTypes = nil
+
+		for _, findingTypeRaw := range v.List() {
+			policyValidationConfig.FailOnFindingTypes = append(policyValidationConfig.FailOnFindingTypes, findingTypeRaw.(string))
+		}
+	}
+
+	if v, ok := m["locale"].(string); ok && v != "" {
+		policyValidationConfig.Locale = v
+	}
+
+	return policyValidationConfig
+}
+
+func expandProviderRetryRules(l []interface{}) ([]conns.RetryRule, error) {
+	var rules []conns.RetryRule
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		hclKey := tfMap["service"].(string)
+		serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure retry (%s): %w", hclKey, err)
+		}
+
+		rule := conns.RetryRule{
+			Service: serviceKey,
+		}
+
+		if v, ok := tfMap["backoff"].(string); ok && v != "" {
+			rule.Backoff = v
+		}
+
+		if v, ok := tfMap["error_code"].(string); ok && v != "" {
+			rule.ErrorCode = v
+		}
+
+		if v, ok := tfMap["error_message"].(string); ok && v != "" {
+			rule.ErrorMessage = v
+		}
+
+		if rule.ErrorCode == "" && rule.ErrorMessage == "" {
+			return nil, fmt.Errorf("failed to configure retry (%s): one of error_code or error_message must be specified", hclKey)
+		}
+
+		if v, ok := tfMap["max_retries"].(int); ok && v != 0 {
+			rule.MaxRetries = v
+		}
+
+		if v, ok := tfMap["operations"].(*schema.Set); ok && v.Len() > 0 {
+			for _, operationRaw := range v.List() {
+				rule.Operations = append(rule.Operations, operationRaw.(string))
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
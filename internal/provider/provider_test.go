@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestCustomizeDiffWithResourceType(t *testing.T) {
+	meta := &conns.AWSClient{
+		TagPolicyConfig: &tftags.PolicyConfig{
+			Rules: []tftags.PolicyRule{
+				{
+					Key:           "CostCenter",
+					Required:      true,
+					ResourceTypes: []string{"aws_test_resource"},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name         string
+		ResourceType string
+		Tags         map[string]interface{}
+		ExpectError  bool
+	}{
+		{
+			Name:         "rule resource type compliant",
+			ResourceType: "aws_test_resource",
+			Tags:         map[string]interface{}{"CostCenter": "1234"},
+		},
+		{
+			Name:         "rule resource type missing tag",
+			ResourceType: "aws_test_resource",
+			Tags:         map[string]interface{}{"Name": "test"},
+			ExpectError:  true,
+		},
+		{
+			Name:         "other resource type missing tag",
+			ResourceType: "aws_other_resource",
+			Tags:         map[string]interface{}{"Name": "test"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags":     tftags.TagsSchema(),
+					"tags_all": tftags.TagsSchemaComputed(),
+				},
+				CustomizeDiff: customizeDiffWithResourceType(testCase.ResourceType, verify.SetTagsDiff),
+			}
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"tags": testCase.Tags,
+			})
+
+			_, err := r.SimpleDiff(context.Background(), &terraform.InstanceState{}, config, meta)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
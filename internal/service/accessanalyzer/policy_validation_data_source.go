@@ -0,0 +1,233 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func DataSourcePolicyValidation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"errors": policyValidationFindingsSchema(),
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.Locale_Values(), false),
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.PolicyType_Values(), false),
+			},
+			"security_warnings": policyValidationFindingsSchema(),
+			"suggestions":       policyValidationFindingsSchema(),
+			"warnings":          policyValidationFindingsSchema(),
+		},
+	}
+}
+
+func policyValidationFindingsSchema() *schema.Schema {
+	positionSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"line": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"offset": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"finding_details": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"issue_code": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"learn_more_link": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"locations": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"path": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"span": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"end":   positionSchema,
+										"start": positionSchema,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicyValidationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn()
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(d.Get("policy_document").(string)),
+		PolicyType:     aws.String(d.Get("policy_type").(string)),
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	findings, err := FindValidatePolicyFindings(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error validating Access Analyzer policy: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(input.String())))
+
+	findingsByType := map[string][]*accessanalyzer.ValidatePolicyFinding{}
+
+	for _, finding := range findings {
+		findingType := aws.StringValue(finding.FindingType)
+		findingsByType[findingType] = append(findingsByType[findingType], finding)
+	}
+
+	for findingType, key := range map[string]string{
+		accessanalyzer.ValidatePolicyFindingTypeError:           "errors",
+		accessanalyzer.ValidatePolicyFindingTypeSecurityWarning: "security_warnings",
+		accessanalyzer.ValidatePolicyFindingTypeSuggestion:      "suggestions",
+		accessanalyzer.ValidatePolicyFindingTypeWarning:         "warnings",
+	} {
+		if err := d.Set(key, flattenValidatePolicyFindings(findingsByType[findingType])); err != nil {
+			return fmt.Errorf("error setting %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenValidatePolicyFindings(apiObjects []*accessanalyzer.ValidatePolicyFinding) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"finding_details": aws.StringValue(apiObject.FindingDetails),
+			"issue_code":      aws.StringValue(apiObject.IssueCode),
+			"learn_more_link": aws.StringValue(apiObject.LearnMoreLink),
+			"locations":       flattenLocations(apiObject.Locations),
+		})
+	}
+
+	return tfList
+}
+
+func flattenLocations(apiObjects []*accessanalyzer.Location) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"path": FlattenPathElements(apiObject.Path),
+		}
+
+		if v := apiObject.Span; v != nil {
+			tfMap["span"] = []interface{}{map[string]interface{}{
+				"end":   flattenPosition(v.End),
+				"start": flattenPosition(v.Start),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPosition(apiObject *accessanalyzer.Position) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"column": aws.Int64Value(apiObject.Column),
+		"line":   aws.Int64Value(apiObject.Line),
+		"offset": aws.Int64Value(apiObject.Offset),
+	}}
+}
+
+// FlattenPathElements renders a policy location path as a JSONPath-like string,
+// e.g. "Statement[0].Condition.StringEquals".
+func FlattenPathElements(apiObjects []*accessanalyzer.PathElement) string {
+	var sb strings.Builder
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		switch {
+		case apiObject.Index != nil:
+			fmt.Fprintf(&sb, "[%d]", aws.Int64Value(apiObject.Index))
+		case apiObject.Key != nil:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(aws.StringValue(apiObject.Key))
+		case apiObject.Value != nil:
+			fmt.Fprintf(&sb, "(%s)", aws.StringValue(apiObject.Value))
+		case apiObject.Substring != nil:
+			fmt.Fprintf(&sb, "{%d,%d}", aws.Int64Value(apiObject.Substring.Start), aws.Int64Value(apiObject.Substring.Length))
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,129 @@
+package accessanalyzer_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+)
+
+func TestFlattenPathElements(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    []*accessanalyzer.PathElement
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			Expected: "",
+		},
+		{
+			Name: "keys and indexes",
+			Input: []*accessanalyzer.PathElement{
+				{Key: aws.String("Statement")},
+				{Index: aws.Int64(0)},
+				{Key: aws.String("Action")},
+				{Index: aws.Int64(1)},
+			},
+			Expected: "Statement[0].Action[1]",
+		},
+		{
+			Name: "value and substring",
+			Input: []*accessanalyzer.PathElement{
+				{Key: aws.String("Statement")},
+				{Index: aws.Int64(2)},
+				{Key: aws.String("Resource")},
+				{Value: aws.String("arn:aws:s3:::example/*")}, //lintignore:AWSAT005
+				{Substring: &accessanalyzer.Substring{Start: aws.Int64(3), Length: aws.Int64(2)}},
+			},
+			Expected: "Statement[2].Resource(arn:aws:s3:::example/*){3,2}", //lintignore:AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := tfaccessanalyzer.FlattenPathElements(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0.issue_code", "INVALID_ACTION"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0.locations.0.path", "Statement[0].Action"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "errors.0.locations.0.span.0.start.0.line"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "errors.0.learn_more_link"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_warnings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_valid(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceValidConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_warnings.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "suggestions.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "warnings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyValidationDataSourceConfig = `
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObjectt"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`
+
+const testAccPolicyValidationDataSourceValidConfig = `
+data "aws_partition" "current" {}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::example/*"
+    }]
+  })
+}
+`
@@ -45,7 +45,7 @@ func ResourceVaultPolicy() *schema.Resource {
 }
 
 func resourceVaultPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	name := d.Get("backup_vault_name").(string)
 	input := &backup.PutBackupVaultAccessPolicyInput{
@@ -65,7 +65,7 @@ func resourceVaultPolicyPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVaultPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	output, err := FindBackupVaultAccessPolicyByName(conn, d.Id())
 
@@ -87,7 +87,7 @@ func resourceVaultPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVaultPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	log.Printf("[DEBUG] Deleting Backup Vault Policy (%s)", d.Id())
 	_, err := conn.DeleteBackupVaultAccessPolicy(&backup.DeleteBackupVaultAccessPolicyInput{
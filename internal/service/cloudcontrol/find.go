@@ -76,3 +76,40 @@ func FindResourceByID(ctx context.Context, conn *cloudcontrolapi.CloudControlApi
 
 	return output.ResourceDescription, nil
 }
+
+func FindResources(ctx context.Context, conn *cloudcontrolapi.CloudControlApi, typeName, typeVersionID, roleARN, resourceModel string) ([]*cloudcontrolapi.ResourceDescription, error) {
+	input := &cloudcontrolapi.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	}
+	if resourceModel != "" {
+		input.ResourceModel = aws.String(resourceModel)
+	}
+	if roleARN != "" {
+		input.RoleArn = aws.String(roleARN)
+	}
+	if typeVersionID != "" {
+		input.TypeVersionId = aws.String(typeVersionID)
+	}
+
+	var output []*cloudcontrolapi.ResourceDescription
+
+	err := conn.ListResourcesPagesWithContext(ctx, input, func(page *cloudcontrolapi.ListResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceDescriptions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
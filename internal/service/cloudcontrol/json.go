@@ -0,0 +1,65 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+)
+
+// removeReadOnlyProperties returns the `properties` JSON document with all of the
+// read-only properties declared in the CloudFormation resource schema removed.
+func removeReadOnlyProperties(properties, resourceSchema string) (string, error) {
+	var document map[string]interface{}
+
+	if err := json.Unmarshal([]byte(properties), &document); err != nil {
+		return "", fmt.Errorf("error parsing properties JSON: %w", err)
+	}
+
+	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(cfschema.Sanitize(resourceSchema))
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	cfResource, err := cfResourceSchema.Resource()
+
+	if err != nil {
+		return "", fmt.Errorf("error converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	for _, readOnlyProperty := range cfResource.ReadOnlyProperties {
+		removePropertyPath(document, readOnlyProperty.Path())
+	}
+
+	b, err := json.Marshal(document)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// removePropertyPath removes the value at `path` from a decoded JSON object.
+// Array elements are traversed so that paths such as /properties/Tags/*/Key are handled.
+func removePropertyPath(v interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			delete(v, path[0])
+			return
+		}
+
+		removePropertyPath(v[path[0]], path[1:])
+	case []interface{}:
+		// Array items are addressed by "*" or an index; treat both as "each item".
+		for _, item := range v {
+			removePropertyPath(item, path[1:])
+		}
+	}
+}
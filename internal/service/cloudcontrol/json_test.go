@@ -0,0 +1,79 @@
+package cloudcontrol
+
+import (
+	"testing"
+)
+
+func TestRemoveReadOnlyProperties(t *testing.T) {
+	resourceSchema := `{
+  "typeName": "Test::Test::Test",
+  "description": "Test",
+  "properties": {
+    "Arn": {"type": "string"},
+    "Name": {"type": "string"},
+    "Tags": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "Key": {"type": "string"},
+          "Value": {"type": "string"},
+          "Source": {"type": "string"}
+        }
+      }
+    }
+  },
+  "primaryIdentifier": ["/properties/Name"],
+  "readOnlyProperties": ["/properties/Arn", "/properties/Tags/*/Source", "/properties/Missing/Nested"]
+}`
+
+	testCases := []struct {
+		TestName   string
+		Properties string
+		Expected   string
+		ErrorCheck bool
+	}{
+		{
+			TestName:   "no read-only properties",
+			Properties: `{"Name":"test"}`,
+			Expected:   `{"Name":"test"}`,
+		},
+		{
+			TestName:   "top-level read-only property",
+			Properties: `{"Arn":"arn:aws:test","Name":"test"}`,
+			Expected:   `{"Name":"test"}`,
+		},
+		{
+			TestName:   "nested read-only property in array",
+			Properties: `{"Name":"test","Tags":[{"Key":"k1","Source":"aws","Value":"v1"},{"Key":"k2","Value":"v2"}]}`,
+			Expected:   `{"Name":"test","Tags":[{"Key":"k1","Value":"v1"},{"Key":"k2","Value":"v2"}]}`,
+		},
+		{
+			TestName:   "invalid properties",
+			Properties: `{`,
+			ErrorCheck: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := removeReadOnlyProperties(testCase.Properties, resourceSchema)
+
+			if testCase.ErrorCheck {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
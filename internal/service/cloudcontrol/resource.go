@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -29,6 +30,10 @@ func ResourceResource() *schema.Resource {
 		ReadContext:   resourceResourceRead,
 		UpdateContext: resourceResourceUpdate,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceImport,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Hour),
 			Delete: schema.DefaultTimeout(2 * time.Hour),
@@ -77,7 +82,7 @@ func ResourceResource() *schema.Resource {
 }
 
 func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	typeName := d.Get("type_name").(string)
 	input := &cloudcontrolapi.CreateResourceInput{
@@ -122,7 +127,7 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	resourceDescription, err := FindResourceByID(ctx, conn,
 		d.Id(),
@@ -147,7 +152,7 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	if d.HasChange("desired_state") {
 		oldRaw, newRaw := d.GetChange("desired_state")
@@ -198,7 +203,7 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	input := &cloudcontrolapi.DeleteResourceInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -237,8 +242,54 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourceResourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	typeName, identifier, err := resourceParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	resourceDescription, err := FindResourceByID(ctx, meta.(*conns.AWSClient).CloudControlConn(), identifier, typeName, "", "")
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Cloud Control API Resource (%s): %w", identifier, err)
+	}
+
+	output, err := tfcloudformation.FindTypeByName(ctx, meta.(*conns.AWSClient).CloudFormationConn(), typeName)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading CloudFormation Type (%s): %w", typeName, err)
+	}
+
+	resourceSchema := aws.StringValue(output.Schema)
+	desiredState, err := removeReadOnlyProperties(aws.StringValue(resourceDescription.Properties), resourceSchema)
+
+	if err != nil {
+		return nil, fmt.Errorf("error building desired_state for Cloud Control API Resource (%s): %w", identifier, err)
+	}
+
+	d.SetId(aws.StringValue(resourceDescription.Identifier))
+	d.Set("desired_state", desiredState)
+	d.Set("schema", resourceSchema)
+	d.Set("type_name", typeName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceParseImportID parses an import ID of the form TYPE_NAME,IDENTIFIER.
+// The identifier itself may contain commas, e.g. for compound primary identifiers.
+func resourceParseImportID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected TYPE_NAME,IDENTIFIER", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
 
 	resourceSchema := diff.Get("schema").(string)
 
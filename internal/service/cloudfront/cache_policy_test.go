@@ -161,7 +161,7 @@ func TestAccCloudFrontCachePolicy_Items(t *testing.T) {
 }
 
 func testAccCheckCloudFrontCachePolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudfront_cache_policy" {
@@ -195,7 +195,7 @@ func testAccCheckCloudFrontCachePolicyExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No CloudFront Cache Policy ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn()
 
 		_, err := tfcloudfront.FindCachePolicyByID(conn, rs.Primary.ID)
 
@@ -233,7 +233,7 @@ func TestAccCloudFrontResponseHeadersPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckCloudFrontResponseHeadersPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudfront_response_headers_policy" {
@@ -267,7 +267,7 @@ func testAccCheckCloudFrontResponseHeadersPolicyExists(n string) resource.TestCh
 			return fmt.Errorf("No CloudFront Response Headers Policy ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn()
 
 		_, err := tfcloudfront.FindResponseHeadersPolicyByID(conn, rs.Primary.ID)
 
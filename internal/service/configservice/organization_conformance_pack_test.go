@@ -414,7 +414,7 @@ func testAccOrganizationConformancePack_updateTemplateBody(t *testing.T) {
 }
 
 func testAccCheckOrganizationConformancePackDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_organization_conformance_pack" {
@@ -452,7 +452,7 @@ func testAccCheckOrganizationConformancePackExists(resourceName string, ocp *con
 			return fmt.Errorf("Not Found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
 
 		pack, err := tfconfig.DescribeOrganizationConformancePack(conn, rs.Primary.ID)
 
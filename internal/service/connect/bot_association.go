@@ -0,0 +1,187 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBotAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBotAssociationCreate,
+		ReadContext:   resourceBotAssociationRead,
+		DeleteContext: resourceBotAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"lex_bot": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lex_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(2, 50),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBotAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+	lexBot := expandBotAssociationLexBot(d.Get("lex_bot").([]interface{}))
+
+	if lexBot.LexRegion == nil {
+		lexBot.LexRegion = aws.String(meta.(*conns.AWSClient).Region)
+	}
+
+	input := &connect.AssociateLexBotInput{
+		InstanceId: aws.String(instanceID),
+		LexBot:     lexBot,
+	}
+
+	id := BotAssociationCreateID(instanceID, aws.StringValue(lexBot.Name), aws.StringValue(lexBot.LexRegion))
+
+	_, err := conn.AssociateLexBotWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Bot Association (%s): %w", id, err))
+	}
+
+	d.SetId(id)
+
+	return resourceBotAssociationRead(ctx, d, meta)
+}
+
+func resourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, name, region, err := BotAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lexBot, err := FindBotAssociationV1ByNameAndRegion(ctx, conn, instanceID, name, region)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Bot Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Connect Bot Association (%s): %w", d.Id(), err))
+	}
+
+	d.Set("instance_id", instanceID)
+
+	if err := d.Set("lex_bot", flattenBotAssociationLexBot(lexBot)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting lex_bot: %w", err))
+	}
+
+	return nil
+}
+
+func resourceBotAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, name, region, err := BotAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Bot Association: %s", d.Id())
+	_, err = conn.DisassociateLexBotWithContext(ctx, &connect.DisassociateLexBotInput{
+		BotName:    aws.String(name),
+		InstanceId: aws.String(instanceID),
+		LexRegion:  aws.String(region),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect Bot Association (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func BotAssociationCreateID(instanceID, name, region string) string {
+	return strings.Join([]string{instanceID, name, region}, ":")
+}
+
+func BotAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:name:region", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func expandBotAssociationLexBot(tfList []interface{}) *connect.LexBot {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connect.LexBot{}
+
+	if v, ok := tfMap["lex_region"].(string); ok && v != "" {
+		apiObject.LexRegion = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBotAssociationLexBot(apiObject *connect.LexBot) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"lex_region": aws.StringValue(apiObject.LexRegion),
+		"name":       aws.StringValue(apiObject.Name),
+	}}
+}
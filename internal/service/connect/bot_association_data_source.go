@@ -0,0 +1,70 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceBotAssociation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBotAssociationRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"lex_bot": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lex_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+	lexBot := expandBotAssociationLexBot(d.Get("lex_bot").([]interface{}))
+
+	if lexBot.LexRegion == nil {
+		lexBot.LexRegion = aws.String(meta.(*conns.AWSClient).Region)
+	}
+
+	name, region := aws.StringValue(lexBot.Name), aws.StringValue(lexBot.LexRegion)
+
+	lexBot, err := FindBotAssociationV1ByNameAndRegion(ctx, conn, instanceID, name, region)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Connect Bot Association (%s,%s): %w", name, region, err))
+	}
+
+	d.Set("instance_id", instanceID)
+
+	if err := d.Set("lex_bot", flattenBotAssociationLexBot(lexBot)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting lex_bot: %w", err))
+	}
+
+	d.SetId(BotAssociationCreateID(instanceID, name, region))
+
+	return nil
+}
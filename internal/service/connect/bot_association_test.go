@@ -0,0 +1,203 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectBotAssociation_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":      testAccBotAssociation_basic,
+		"disappears": testAccBotAssociation_disappears,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccBotAssociation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	// Lex bot names may only contain letters.
+	rName2 := sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	resourceName := "aws_connect_bot_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBotAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAssociationConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "lex_bot.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lex_bot.0.name", "aws_lex_bot.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "lex_bot.0.lex_region", "data.aws_region.current", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBotAssociation_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	resourceName := "aws_connect_bot_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBotAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAssociationConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfconnect.ResourceBotAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBotAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Bot Association not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Bot Association ID not set")
+		}
+
+		instanceID, name, region, err := tfconnect.BotAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+		_, err = tfconnect.FindBotAssociationV1ByNameAndRegion(context.Background(), conn, instanceID, name, region)
+
+		return err
+	}
+}
+
+func testAccCheckBotAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_bot_association" {
+			continue
+		}
+
+		instanceID, name, region, err := tfconnect.BotAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfconnect.FindBotAssociationV1ByNameAndRegion(context.Background(), conn, instanceID, name, region)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Bot Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBotAssociationBaseConfig(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccContactFlowBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_lex_intent" "test" {
+  create_version = true
+  name           = %[1]q
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+
+  sample_utterances = [
+    "I would like to pick up flowers",
+  ]
+}
+
+resource "aws_lex_bot" "test" {
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  clarification_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "I didn't understand you, what would you like to do?"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = aws_lex_intent.test.name
+    intent_version = "1"
+  }
+
+  child_directed   = false
+  name             = %[1]q
+  process_behavior = "BUILD"
+}
+`, rName2))
+}
+
+func testAccBotAssociationConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccBotAssociationBaseConfig(rName, rName2),
+		`
+resource "aws_connect_bot_association" "test" {
+  instance_id = aws_connect_instance.test.id
+
+  lex_bot {
+    lex_region = data.aws_region.current.name
+    name       = aws_lex_bot.test.name
+  }
+}
+`)
+}
@@ -0,0 +1,361 @@
+package connect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func FindHoursOfOperationByID(ctx context.Context, conn *connect.Connect, instanceID, hoursOfOperationID string) (*connect.HoursOfOperation, error) {
+	input := &connect.DescribeHoursOfOperationInput{
+		HoursOfOperationId: aws.String(hoursOfOperationID),
+		InstanceId:         aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeHoursOfOperationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.HoursOfOperation == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.HoursOfOperation, nil
+}
+
+func FindQueueByID(ctx context.Context, conn *connect.Connect, instanceID, queueID string) (*connect.Queue, error) {
+	input := &connect.DescribeQueueInput{
+		InstanceId: aws.String(instanceID),
+		QueueId:    aws.String(queueID),
+	}
+
+	output, err := conn.DescribeQueueWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Queue == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Queue, nil
+}
+
+func FindQueueQuickConnectIDs(ctx context.Context, conn *connect.Connect, instanceID, queueID string) ([]*string, error) {
+	input := &connect.ListQueueQuickConnectsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListQueueQuickConnectsMaxResults),
+		QueueId:    aws.String(queueID),
+	}
+	var output []*string
+
+	err := conn.ListQueueQuickConnectsPagesWithContext(ctx, input, func(page *connect.ListQueueQuickConnectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.QuickConnectSummaryList {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, v.Id)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindRoutingProfileByID(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string) (*connect.RoutingProfile, error) {
+	input := &connect.DescribeRoutingProfileInput{
+		InstanceId:       aws.String(instanceID),
+		RoutingProfileId: aws.String(routingProfileID),
+	}
+
+	output, err := conn.DescribeRoutingProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RoutingProfile == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.RoutingProfile, nil
+}
+
+func FindRoutingProfileQueueConfigs(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string) ([]*connect.RoutingProfileQueueConfigSummary, error) {
+	input := &connect.ListRoutingProfileQueuesInput{
+		InstanceId:       aws.String(instanceID),
+		MaxResults:       aws.Int64(ListRoutingProfileQueuesMaxResults),
+		RoutingProfileId: aws.String(routingProfileID),
+	}
+	var output []*connect.RoutingProfileQueueConfigSummary
+
+	err := conn.ListRoutingProfileQueuesPagesWithContext(ctx, input, func(page *connect.ListRoutingProfileQueuesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RoutingProfileQueueConfigSummaryList {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindSecurityProfileByID(ctx context.Context, conn *connect.Connect, instanceID, securityProfileID string) (*connect.SecurityProfile, error) {
+	input := &connect.DescribeSecurityProfileInput{
+		InstanceId:        aws.String(instanceID),
+		SecurityProfileId: aws.String(securityProfileID),
+	}
+
+	output, err := conn.DescribeSecurityProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityProfile == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.SecurityProfile, nil
+}
+
+func FindSecurityProfilePermissions(ctx context.Context, conn *connect.Connect, instanceID, securityProfileID string) ([]*string, error) {
+	input := &connect.ListSecurityProfilePermissionsInput{
+		InstanceId:        aws.String(instanceID),
+		MaxResults:        aws.Int64(ListSecurityProfilePermissionsMaxResults),
+		SecurityProfileId: aws.String(securityProfileID),
+	}
+	var output []*string
+
+	err := conn.ListSecurityProfilePermissionsPagesWithContext(ctx, input, func(page *connect.ListSecurityProfilePermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Permissions...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindUserByID(ctx context.Context, conn *connect.Connect, instanceID, userID string) (*connect.User, error) {
+	input := &connect.DescribeUserInput{
+		InstanceId: aws.String(instanceID),
+		UserId:     aws.String(userID),
+	}
+
+	output, err := conn.DescribeUserWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.User == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.User, nil
+}
+
+func FindQuickConnectByID(ctx context.Context, conn *connect.Connect, instanceID, quickConnectID string) (*connect.QuickConnect, error) {
+	input := &connect.DescribeQuickConnectInput{
+		InstanceId:     aws.String(instanceID),
+		QuickConnectId: aws.String(quickConnectID),
+	}
+
+	output, err := conn.DescribeQuickConnectWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QuickConnect == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.QuickConnect, nil
+}
+
+func FindLambdaFunctionAssociationByARN(ctx context.Context, conn *connect.Connect, instanceID, functionARN string) (string, error) {
+	input := &connect.ListLambdaFunctionsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListLambdaFunctionsMaxResults),
+	}
+	var result string
+
+	err := conn.ListLambdaFunctionsPagesWithContext(ctx, input, func(page *connect.ListLambdaFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LambdaFunctions {
+			if aws.StringValue(v) == functionARN {
+				result = functionARN
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if result == "" {
+		return "", &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+func FindBotAssociationV1ByNameAndRegion(ctx context.Context, conn *connect.Connect, instanceID, name, region string) (*connect.LexBot, error) {
+	input := &connect.ListLexBotsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListLexBotsMaxResults),
+	}
+	var result *connect.LexBot
+
+	err := conn.ListLexBotsPagesWithContext(ctx, input, func(page *connect.ListLexBotsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LexBots {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == name && aws.StringValue(v.LexRegion) == region {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,136 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLambdaFunctionAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLambdaFunctionAssociationCreate,
+		ReadContext:   resourceLambdaFunctionAssociationRead,
+		DeleteContext: resourceLambdaFunctionAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceLambdaFunctionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+	functionARN := d.Get("function_arn").(string)
+
+	input := &connect.AssociateLambdaFunctionInput{
+		FunctionArn: aws.String(functionARN),
+		InstanceId:  aws.String(instanceID),
+	}
+
+	_, err := conn.AssociateLambdaFunctionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Lambda Function Association (%s,%s): %w", instanceID, functionARN, err))
+	}
+
+	d.SetId(LambdaFunctionAssociationCreateID(instanceID, functionARN))
+
+	return resourceLambdaFunctionAssociationRead(ctx, d, meta)
+}
+
+func resourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, functionARN, err := LambdaFunctionAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = FindLambdaFunctionAssociationByARN(ctx, conn, instanceID, functionARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Lambda Function Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Connect Lambda Function Association (%s): %w", d.Id(), err))
+	}
+
+	d.Set("function_arn", functionARN)
+	d.Set("instance_id", instanceID)
+
+	return nil
+}
+
+func resourceLambdaFunctionAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, functionARN, err := LambdaFunctionAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Lambda Function Association: %s", d.Id())
+	_, err = conn.DisassociateLambdaFunctionWithContext(ctx, &connect.DisassociateLambdaFunctionInput{
+		FunctionArn: aws.String(functionARN),
+		InstanceId:  aws.String(instanceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect Lambda Function Association (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+const lambdaFunctionAssociationIDSeparator = ","
+
+func LambdaFunctionAssociationCreateID(instanceID, functionARN string) string {
+	return strings.Join([]string{instanceID, functionARN}, lambdaFunctionAssociationIDSeparator)
+}
+
+// LambdaFunctionAssociationParseID parses an ID of the form instanceID,functionARN.
+// A comma separator is used as function ARNs contain colons.
+func LambdaFunctionAssociationParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, lambdaFunctionAssociationIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID,functionARN", id)
+	}
+
+	return parts[0], parts[1], nil
+}
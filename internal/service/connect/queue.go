@@ -0,0 +1,415 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceQueueCreate,
+		ReadContext:   resourceQueueRead,
+		UpdateContext: resourceQueueUpdate,
+		// Queues do not support deletion today. NoOp the Delete method.
+		// Users can rename their queues manually if they want.
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"hours_of_operation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"max_contacts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"outbound_caller_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"outbound_caller_id_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"outbound_caller_id_number_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"outbound_flow_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 500),
+						},
+					},
+				},
+			},
+			"queue_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"quick_connect_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(connect.QueueStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateQueueInput{
+		HoursOfOperationId: aws.String(d.Get("hours_of_operation_id").(string)),
+		InstanceId:         aws.String(instanceID),
+		Name:               aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_contacts"); ok {
+		input.MaxContacts = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("outbound_caller_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OutboundCallerConfig = expandQueueOutboundCallerConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("quick_connect_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.QuickConnectIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateQueueWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Queue (%s): %w", name, err))
+	}
+
+	if output == nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Queue (%s): empty output", name))
+	}
+
+	queueID := aws.StringValue(output.QueueId)
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, queueID))
+
+	// Queues are always created enabled.
+	if v, ok := d.GetOk("status"); ok && v.(string) != connect.QueueStatusEnabled {
+		if err := updateQueueStatus(ctx, conn, instanceID, queueID, v.(string)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Queue (%s) status: %w", d.Id(), err))
+		}
+	}
+
+	return resourceQueueRead(ctx, d, meta)
+}
+
+func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID, queueID, err := QueueParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	queue, err := FindQueueByID(ctx, conn, instanceID, queueID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Connect Queue (%s): %w", d.Id(), err))
+	}
+
+	d.Set("arn", queue.QueueArn)
+	d.Set("description", queue.Description)
+	d.Set("hours_of_operation_id", queue.HoursOfOperationId)
+	d.Set("instance_id", instanceID)
+	d.Set("max_contacts", queue.MaxContacts)
+	d.Set("name", queue.Name)
+	d.Set("queue_id", queue.QueueId)
+	d.Set("status", queue.Status)
+
+	if queue.OutboundCallerConfig != nil {
+		if err := d.Set("outbound_caller_config", []interface{}{flattenQueueOutboundCallerConfig(queue.OutboundCallerConfig)}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting outbound_caller_config: %w", err))
+		}
+	} else {
+		d.Set("outbound_caller_config", nil)
+	}
+
+	quickConnectIDs, err := FindQueueQuickConnectIDs(ctx, conn, instanceID, queueID)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Connect Queue (%s) quick connects: %w", d.Id(), err))
+	}
+
+	if err := d.Set("quick_connect_ids", aws.StringValueSlice(quickConnectIDs)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting quick_connect_ids: %w", err))
+	}
+
+	tags := KeyValueTags(queue.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, queueID, err := QueueParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "name") {
+		input := &connect.UpdateQueueNameInput{
+			Description: aws.String(d.Get("description").(string)),
+			InstanceId:  aws.String(instanceID),
+			Name:        aws.String(d.Get("name").(string)),
+			QueueId:     aws.String(queueID),
+		}
+
+		_, err := conn.UpdateQueueNameWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Queue (%s) name: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("hours_of_operation_id") {
+		input := &connect.UpdateQueueHoursOfOperationInput{
+			HoursOfOperationId: aws.String(d.Get("hours_of_operation_id").(string)),
+			InstanceId:         aws.String(instanceID),
+			QueueId:            aws.String(queueID),
+		}
+
+		_, err := conn.UpdateQueueHoursOfOperationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Queue (%s) hours of operation: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("max_contacts") {
+		input := &connect.UpdateQueueMaxContactsInput{
+			InstanceId: aws.String(instanceID),
+			QueueId:    aws.String(queueID),
+		}
+
+		if v, ok := d.GetOk("max_contacts"); ok {
+			input.MaxContacts = aws.Int64(int64(v.(int)))
+		}
+
+		_, err := conn.UpdateQueueMaxContactsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Queue (%s) max contacts: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("outbound_caller_config") {
+		input := &connect.UpdateQueueOutboundCallerConfigInput{
+			InstanceId:           aws.String(instanceID),
+			OutboundCallerConfig: &connect.OutboundCallerConfig{},
+			QueueId:              aws.String(queueID),
+		}
+
+		if v, ok := d.GetOk("outbound_caller_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OutboundCallerConfig = expandQueueOutboundCallerConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateQueueOutboundCallerConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Queue (%s) outbound caller config: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("status") {
+		if err := updateQueueStatus(ctx, conn, instanceID, queueID, d.Get("status").(string)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Queue (%s) status: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("quick_connect_ids") {
+		o, n := d.GetChange("quick_connect_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input := &connect.DisassociateQueueQuickConnectsInput{
+				InstanceId:      aws.String(instanceID),
+				QueueId:         aws.String(queueID),
+				QuickConnectIds: flex.ExpandStringSet(del),
+			}
+
+			_, err := conn.DisassociateQueueQuickConnectsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error disassociating Connect Queue (%s) quick connects: %w", d.Id(), err))
+			}
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input := &connect.AssociateQueueQuickConnectsInput{
+				InstanceId:      aws.String(instanceID),
+				QueueId:         aws.String(queueID),
+				QuickConnectIds: flex.ExpandStringSet(add),
+			}
+
+			_, err := conn.AssociateQueueQuickConnectsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error associating Connect Queue (%s) quick connects: %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
+		}
+	}
+
+	return resourceQueueRead(ctx, d, meta)
+}
+
+func updateQueueStatus(ctx context.Context, conn *connect.Connect, instanceID, queueID, status string) error {
+	input := &connect.UpdateQueueStatusInput{
+		InstanceId: aws.String(instanceID),
+		QueueId:    aws.String(queueID),
+		Status:     aws.String(status),
+	}
+
+	_, err := conn.UpdateQueueStatusWithContext(ctx, input)
+
+	return err
+}
+
+func QueueParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:queueID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandQueueOutboundCallerConfig(tfMap map[string]interface{}) *connect.OutboundCallerConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &connect.OutboundCallerConfig{}
+
+	if v, ok := tfMap["outbound_caller_id_name"].(string); ok && v != "" {
+		apiObject.OutboundCallerIdName = aws.String(v)
+	}
+
+	if v, ok := tfMap["outbound_caller_id_number_id"].(string); ok && v != "" {
+		apiObject.OutboundCallerIdNumberId = aws.String(v)
+	}
+
+	if v, ok := tfMap["outbound_flow_id"].(string); ok && v != "" {
+		apiObject.OutboundFlowId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenQueueOutboundCallerConfig(apiObject *connect.OutboundCallerConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OutboundCallerIdName; v != nil {
+		tfMap["outbound_caller_id_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OutboundCallerIdNumberId; v != nil {
+		tfMap["outbound_caller_id_number_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OutboundFlowId; v != nil {
+		tfMap["outbound_flow_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
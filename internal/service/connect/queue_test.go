@@ -0,0 +1,278 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectQueue_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":        testAccQueue_basic,
+		"quickConnect": testAccQueue_quickConnect,
+		"disappears":   testAccQueue_disappears_ConnectInstance,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccQueue_basic(t *testing.T) {
+	var v connect.Queue
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_queue.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_basic(rName, rName2, "Created", connect.QueueStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "queue_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "hours_of_operation_id", "aws_connect_hours_of_operation.test", "hours_of_operation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created"),
+					resource.TestCheckResourceAttr(resourceName, "max_contacts", "10"),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.QueueStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_basic(rName, rName2, "Updated", connect.QueueStatusDisabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.QueueStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueue_quickConnect(t *testing.T) {
+	var v connect.Queue
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_queue.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_quickConnect(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "quick_connect_ids.*", "aws_connect_quick_connect.test", "quick_connect_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_basic(rName, rName2, "Updated", connect.QueueStatusEnabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Can't delete a queue. Test deletion of entire connect instance
+func testAccQueue_disappears_ConnectInstance(t *testing.T) {
+	var v connect.Queue
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_queue.test"
+	instanceResourceName := "aws_connect_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_basic(rName, rName2, "Disappear", connect.QueueStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfconnect.ResourceInstance(), instanceResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQueueExists(resourceName string, v *connect.Queue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Queue not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Queue ID not set")
+		}
+
+		instanceID, queueID, err := tfconnect.QueueParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+		output, err := tfconnect.FindQueueByID(context.Background(), conn, instanceID, queueID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// Queues are only removed along with their instance.
+func testAccCheckQueueDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_queue" {
+			continue
+		}
+
+		instanceID, queueID, err := tfconnect.QueueParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfconnect.FindQueueByID(context.Background(), conn, instanceID, queueID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Queue %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccQueueBaseConfig(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccContactFlowBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_hours_of_operation" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  time_zone   = "EST"
+
+  config {
+    day = "MONDAY"
+
+    end_time {
+      hours   = 17
+      minutes = 0
+    }
+
+    start_time {
+      hours   = 8
+      minutes = 0
+    }
+  }
+}
+`, rName2))
+}
+
+func testAccQueueConfig_basic(rName, rName2, label, status string) string {
+	return acctest.ConfigCompose(
+		testAccQueueBaseConfig(rName, rName2),
+		fmt.Sprintf(`
+resource "aws_connect_queue" "test" {
+  instance_id           = aws_connect_instance.test.id
+  name                  = %[1]q
+  description           = %[2]q
+  hours_of_operation_id = aws_connect_hours_of_operation.test.hours_of_operation_id
+  max_contacts          = 10
+  status                = %[3]q
+
+  tags = {
+    "Name" = "Test Queue"
+  }
+}
+`, rName2, label, status))
+}
+
+func testAccQueueConfig_quickConnect(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccQueueBaseConfig(rName, rName2),
+		fmt.Sprintf(`
+resource "aws_connect_quick_connect" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+
+  quick_connect_config {
+    quick_connect_type = "PHONE_NUMBER"
+
+    phone_config {
+      phone_number = "+12345678912"
+    }
+  }
+}
+
+resource "aws_connect_queue" "test" {
+  instance_id           = aws_connect_instance.test.id
+  name                  = %[1]q
+  description           = "Updated"
+  hours_of_operation_id = aws_connect_hours_of_operation.test.hours_of_operation_id
+  max_contacts          = 10
+  quick_connect_ids     = [aws_connect_quick_connect.test.quick_connect_id]
+
+  tags = {
+    "Name" = "Test Queue"
+  }
+}
+`, rName2))
+}
@@ -0,0 +1,359 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceQuickConnect() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceQuickConnectCreate,
+		ReadContext:   resourceQuickConnectRead,
+		UpdateContext: resourceQuickConnectUpdate,
+		DeleteContext: resourceQuickConnectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"quick_connect_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phone_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"phone_number": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"queue_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"contact_flow_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"queue_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"quick_connect_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.QuickConnectType_Values(), false),
+						},
+						"user_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"contact_flow_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"user_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"quick_connect_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceQuickConnectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateQuickConnectInput{
+		InstanceId:         aws.String(instanceID),
+		Name:               aws.String(name),
+		QuickConnectConfig: expandQuickConnectConfig(d.Get("quick_connect_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateQuickConnectWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Quick Connect (%s): %w", name, err))
+	}
+
+	if output == nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Quick Connect (%s): empty output", name))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.QuickConnectId)))
+
+	return resourceQuickConnectRead(ctx, d, meta)
+}
+
+func resourceQuickConnectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID, quickConnectID, err := QuickConnectParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	quickConnect, err := FindQuickConnectByID(ctx, conn, instanceID, quickConnectID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Quick Connect (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Connect Quick Connect (%s): %w", d.Id(), err))
+	}
+
+	d.Set("arn", quickConnect.QuickConnectARN)
+	d.Set("description", quickConnect.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", quickConnect.Name)
+	d.Set("quick_connect_id", quickConnect.QuickConnectId)
+
+	if err := d.Set("quick_connect_config", flattenQuickConnectConfig(quickConnect.QuickConnectConfig)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting quick_connect_config: %w", err))
+	}
+
+	tags := KeyValueTags(quickConnect.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceQuickConnectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, quickConnectID, err := QuickConnectParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "name") {
+		input := &connect.UpdateQuickConnectNameInput{
+			Description:    aws.String(d.Get("description").(string)),
+			InstanceId:     aws.String(instanceID),
+			Name:           aws.String(d.Get("name").(string)),
+			QuickConnectId: aws.String(quickConnectID),
+		}
+
+		_, err := conn.UpdateQuickConnectNameWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Quick Connect (%s) name: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("quick_connect_config") {
+		input := &connect.UpdateQuickConnectConfigInput{
+			InstanceId:         aws.String(instanceID),
+			QuickConnectConfig: expandQuickConnectConfig(d.Get("quick_connect_config").([]interface{})),
+			QuickConnectId:     aws.String(quickConnectID),
+		}
+
+		_, err := conn.UpdateQuickConnectConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Quick Connect (%s) config: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
+		}
+	}
+
+	return resourceQuickConnectRead(ctx, d, meta)
+}
+
+func resourceQuickConnectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, quickConnectID, err := QuickConnectParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Quick Connect: %s", d.Id())
+	_, err = conn.DeleteQuickConnectWithContext(ctx, &connect.DeleteQuickConnectInput{
+		InstanceId:     aws.String(instanceID),
+		QuickConnectId: aws.String(quickConnectID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect Quick Connect (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func QuickConnectParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:quickConnectID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandQuickConnectConfig(tfList []interface{}) *connect.QuickConnectConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connect.QuickConnectConfig{}
+
+	if v, ok := tfMap["phone_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.PhoneConfig = &connect.PhoneNumberQuickConnectConfig{
+			PhoneNumber: aws.String(m["phone_number"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["queue_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.QueueConfig = &connect.QueueQuickConnectConfig{
+			ContactFlowId: aws.String(m["contact_flow_id"].(string)),
+			QueueId:       aws.String(m["queue_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["quick_connect_type"].(string); ok && v != "" {
+		apiObject.QuickConnectType = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.UserConfig = &connect.UserQuickConnectConfig{
+			ContactFlowId: aws.String(m["contact_flow_id"].(string)),
+			UserId:        aws.String(m["user_id"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenQuickConnectConfig(apiObject *connect.QuickConnectConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"quick_connect_type": aws.StringValue(apiObject.QuickConnectType),
+	}
+
+	if v := apiObject.PhoneConfig; v != nil {
+		tfMap["phone_config"] = []interface{}{map[string]interface{}{
+			"phone_number": aws.StringValue(v.PhoneNumber),
+		}}
+	}
+
+	if v := apiObject.QueueConfig; v != nil {
+		tfMap["queue_config"] = []interface{}{map[string]interface{}{
+			"contact_flow_id": aws.StringValue(v.ContactFlowId),
+			"queue_id":        aws.StringValue(v.QueueId),
+		}}
+	}
+
+	if v := apiObject.UserConfig; v != nil {
+		tfMap["user_config"] = []interface{}{map[string]interface{}{
+			"contact_flow_id": aws.StringValue(v.ContactFlowId),
+			"user_id":         aws.StringValue(v.UserId),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
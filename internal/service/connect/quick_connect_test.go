@@ -0,0 +1,185 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectQuickConnect_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":      testAccQuickConnect_basic,
+		"disappears": testAccQuickConnect_disappears,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccQuickConnect_basic(t *testing.T) {
+	var v connect.QuickConnect
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_quick_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuickConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickConnectConfig_basic(rName, rName2, "Created", "+12345678912"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickConnectExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "quick_connect_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created"),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.0.quick_connect_type", connect.QuickConnectTypePhoneNumber),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.0.phone_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.0.phone_config.0.phone_number", "+12345678912"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQuickConnectConfig_basic(rName, rName2, "Updated", "+12345678913"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQuickConnectExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_config.0.phone_config.0.phone_number", "+12345678913"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQuickConnect_disappears(t *testing.T) {
+	var v connect.QuickConnect
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_quick_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuickConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickConnectConfig_basic(rName, rName2, "Disappear", "+12345678912"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickConnectExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfconnect.ResourceQuickConnect(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickConnectExists(resourceName string, v *connect.QuickConnect) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Quick Connect not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Quick Connect ID not set")
+		}
+
+		instanceID, quickConnectID, err := tfconnect.QuickConnectParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+		output, err := tfconnect.FindQuickConnectByID(context.Background(), conn, instanceID, quickConnectID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickConnectDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_quick_connect" {
+			continue
+		}
+
+		instanceID, quickConnectID, err := tfconnect.QuickConnectParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfconnect.FindQuickConnectByID(context.Background(), conn, instanceID, quickConnectID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Quick Connect %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccQuickConnectConfig_basic(rName, rName2, label, phoneNumber string) string {
+	return acctest.ConfigCompose(
+		testAccContactFlowBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_quick_connect" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = %[2]q
+
+  quick_connect_config {
+    quick_connect_type = "PHONE_NUMBER"
+
+    phone_config {
+      phone_number = %[3]q
+    }
+  }
+
+  tags = {
+    "Name" = "Test Quick Connect"
+  }
+}
+`, rName2, label, phoneNumber))
+}
@@ -0,0 +1,185 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceRoutingProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRoutingProfileRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_outbound_queue_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"media_concurrencies": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"concurrency": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "routing_profile_id"},
+			},
+			"queue_configs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delay": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"queue_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"routing_profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"routing_profile_id", "name"},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceRoutingProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID := d.Get("instance_id").(string)
+
+	var routingProfileID string
+
+	if v, ok := d.GetOk("routing_profile_id"); ok {
+		routingProfileID = v.(string)
+	} else if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		routingProfileSummary, err := dataSourceGetConnectRoutingProfileSummaryByName(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error finding Connect Routing Profile Summary by name (%s): %w", name, err))
+		}
+
+		if routingProfileSummary == nil {
+			return diag.FromErr(fmt.Errorf("error finding Connect Routing Profile Summary by name (%s): not found", name))
+		}
+
+		routingProfileID = aws.StringValue(routingProfileSummary.Id)
+	}
+
+	routingProfile, err := FindRoutingProfileByID(ctx, conn, instanceID, routingProfileID)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile (%s): %w", routingProfileID, err))
+	}
+
+	d.Set("arn", routingProfile.RoutingProfileArn)
+	d.Set("default_outbound_queue_id", routingProfile.DefaultOutboundQueueId)
+	d.Set("description", routingProfile.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", routingProfile.Name)
+	d.Set("routing_profile_id", routingProfile.RoutingProfileId)
+
+	if err := d.Set("media_concurrencies", flattenRoutingProfileMediaConcurrencies(routingProfile.MediaConcurrencies)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting media_concurrencies: %w", err))
+	}
+
+	queueConfigs, err := FindRoutingProfileQueueConfigs(ctx, conn, instanceID, routingProfileID)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile (%s) queues: %w", routingProfileID, err))
+	}
+
+	if err := d.Set("queue_configs", flattenRoutingProfileQueueConfigSummaries(queueConfigs)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting queue_configs: %w", err))
+	}
+
+	if err := d.Set("tags", KeyValueTags(routingProfile.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(routingProfile.RoutingProfileId)))
+
+	return nil
+}
+
+func dataSourceGetConnectRoutingProfileSummaryByName(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.RoutingProfileSummary, error) {
+	var result *connect.RoutingProfileSummary
+
+	input := &connect.ListRoutingProfilesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListRoutingProfilesMaxResults),
+	}
+
+	err := conn.ListRoutingProfilesPagesWithContext(ctx, input, func(page *connect.ListRoutingProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RoutingProfileSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == name {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -17,10 +17,53 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_connect_bot_association", &resource.Sweeper{
+		Name: "aws_connect_bot_association",
+		F:    sweepBotAssociations,
+	})
+
+	resource.AddTestSweepers("aws_connect_hours_of_operation", &resource.Sweeper{
+		Name: "aws_connect_hours_of_operation",
+		F:    sweepHoursOfOperations,
+	})
+
 	resource.AddTestSweepers("aws_connect_instance", &resource.Sweeper{
 		Name: "aws_connect_instance",
 		F:    sweepInstance,
+		Dependencies: []string{
+			"aws_connect_bot_association",
+			"aws_connect_hours_of_operation",
+			"aws_connect_lambda_function_association",
+			"aws_connect_quick_connect",
+			"aws_connect_security_profile",
+			"aws_connect_user",
+		},
+	})
+
+	resource.AddTestSweepers("aws_connect_lambda_function_association", &resource.Sweeper{
+		Name: "aws_connect_lambda_function_association",
+		F:    sweepLambdaFunctionAssociations,
+	})
+
+	resource.AddTestSweepers("aws_connect_quick_connect", &resource.Sweeper{
+		Name: "aws_connect_quick_connect",
+		F:    sweepQuickConnects,
+	})
+
+	resource.AddTestSweepers("aws_connect_security_profile", &resource.Sweeper{
+		Name: "aws_connect_security_profile",
+		F:    sweepSecurityProfiles,
+		Dependencies: []string{
+			"aws_connect_user",
+		},
 	})
+
+	resource.AddTestSweepers("aws_connect_user", &resource.Sweeper{
+		Name: "aws_connect_user",
+		F:    sweepUsers,
+	})
+
+	// Queues and routing profiles cannot be deleted and are only removed along with their instance.
 }
 
 func sweepInstance(region string) error {
@@ -29,7 +72,7 @@ func sweepInstance(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ConnectConn
+	conn := client.(*conns.AWSClient).ConnectConn()
 	ctx := context.Background()
 	var errs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -75,3 +118,271 @@ func sweepInstance(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+// sweepInstanceResources lists the resources of the specified type in every Connect instance in the region
+// and sweeps them. The list function is called once per instance and returns the resources to sweep.
+func sweepInstanceResources(region, resourceType string, list func(ctx context.Context, conn *connect.Connect, client interface{}, instanceID string) ([]*sweep.SweepResource, error)) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).ConnectConn()
+	ctx := context.Background()
+	var errs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	input := &connect.ListInstancesInput{MaxResults: aws.Int64(ListInstancesMaxResults)}
+
+	err = conn.ListInstancesPagesWithContext(ctx, input, func(page *connect.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, instanceSummary := range page.InstanceSummaryList {
+			if instanceSummary == nil {
+				continue
+			}
+
+			instanceID := aws.StringValue(instanceSummary.Id)
+
+			r, err := list(ctx, conn, client, instanceID)
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing %s for Connect Instance (%s): %w", resourceType, instanceID, err))
+				continue
+			}
+
+			sweepResources = append(sweepResources, r...)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Connect Instances: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping %s for %s: %w", resourceType, region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping %s sweep for %s: %s", resourceType, region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepBotAssociations(region string) error {
+	return sweepInstanceResources(region, "Connect Bot Associations", func(ctx context.Context, conn *connect.Connect, client interface{}, instanceID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		input := &connect.ListLexBotsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListLexBotsMaxResults),
+		}
+
+		err := conn.ListLexBotsPagesWithContext(ctx, input, func(page *connect.ListLexBotsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.LexBots {
+				if v == nil {
+					continue
+				}
+
+				id := BotAssociationCreateID(instanceID, aws.StringValue(v.Name), aws.StringValue(v.LexRegion))
+
+				log.Printf("[INFO] Deleting Connect Bot Association (%s)", id)
+				r := ResourceBotAssociation()
+				d := r.Data(nil)
+				d.SetId(id)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepHoursOfOperations(region string) error {
+	return sweepInstanceResources(region, "Connect Hours of Operations", func(ctx context.Context, conn *connect.Connect, client interface{}, instanceID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		input := &connect.ListHoursOfOperationsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListHoursOfOperationsMaxResults),
+		}
+
+		err := conn.ListHoursOfOperationsPagesWithContext(ctx, input, func(page *connect.ListHoursOfOperationsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.HoursOfOperationSummaryList {
+				if v == nil {
+					continue
+				}
+
+				id := fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id))
+
+				log.Printf("[INFO] Deleting Connect Hours of Operation (%s)", id)
+				r := ResourceHoursOfOperation()
+				d := r.Data(nil)
+				d.SetId(id)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepLambdaFunctionAssociations(region string) error {
+	return sweepInstanceResources(region, "Connect Lambda Function Associations", func(ctx context.Context, conn *connect.Connect, client interface{}, instanceID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		input := &connect.ListLambdaFunctionsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListLambdaFunctionsMaxResults),
+		}
+
+		err := conn.ListLambdaFunctionsPagesWithContext(ctx, input, func(page *connect.ListLambdaFunctionsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.LambdaFunctions {
+				id := LambdaFunctionAssociationCreateID(instanceID, aws.StringValue(v))
+
+				log.Printf("[INFO] Deleting Connect Lambda Function Association (%s)", id)
+				r := ResourceLambdaFunctionAssociation()
+				d := r.Data(nil)
+				d.SetId(id)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepQuickConnects(region string) error {
+	return sweepInstanceResources(region, "Connect Quick Connects", func(ctx context.Context, conn *connect.Connect, client interface{}, instanceID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		input := &connect.ListQuickConnectsInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListQuickConnectsMaxResults),
+		}
+
+		err := conn.ListQuickConnectsPagesWithContext(ctx, input, func(page *connect.ListQuickConnectsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.QuickConnectSummaryList {
+				if v == nil {
+					continue
+				}
+
+				id := fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id))
+
+				log.Printf("[INFO] Deleting Connect Quick Connect (%s)", id)
+				r := ResourceQuickConnect()
+				d := r.Data(nil)
+				d.SetId(id)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepSecurityProfiles(region string) error {
+	return sweepInstanceResources(region, "Connect Security Profiles", func(ctx context.Context, conn *connect.Connect, client interface{}, instanceID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		input := &connect.ListSecurityProfilesInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListSecurityProfilesMaxResults),
+		}
+
+		err := conn.ListSecurityProfilesPagesWithContext(ctx, input, func(page *connect.ListSecurityProfilesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.SecurityProfileSummaryList {
+				if v == nil {
+					continue
+				}
+
+				id := fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id))
+
+				log.Printf("[INFO] Deleting Connect Security Profile (%s)", id)
+				r := ResourceSecurityProfile()
+				d := r.Data(nil)
+				d.SetId(id)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepUsers(region string) error {
+	return sweepInstanceResources(region, "Connect Users", func(ctx context.Context, conn *connect.Connect, client interface{}, instanceID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		input := &connect.ListUsersInput{
+			InstanceId: aws.String(instanceID),
+			MaxResults: aws.Int64(ListUsersMaxResults),
+		}
+
+		err := conn.ListUsersPagesWithContext(ctx, input, func(page *connect.ListUsersOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.UserSummaryList {
+				if v == nil {
+					continue
+				}
+
+				id := fmt.Sprintf("%s:%s", instanceID, aws.StringValue(v.Id))
+
+				log.Printf("[INFO] Deleting Connect User (%s)", id)
+				r := ResourceUser()
+				d := r.Data(nil)
+				d.SetId(id)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
@@ -0,0 +1,219 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectUser_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":      testAccUser_basic,
+		"disappears": testAccUser_disappears,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccUser_basic(t *testing.T) {
+	var v connect.User
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName, rName2, rName3, "Created", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName3),
+					resource.TestCheckResourceAttr(resourceName, "identity_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity_info.0.first_name", "Created"),
+					resource.TestCheckResourceAttr(resourceName, "phone_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "phone_config.0.after_contact_work_time_limit", "0"),
+					resource.TestCheckResourceAttr(resourceName, "phone_config.0.phone_type", connect.PhoneTypeSoftPhone),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_profile_id", "aws_connect_routing_profile.test", "routing_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_profile_ids.*", "aws_connect_security_profile.test", "security_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testAccUserConfig_basic(rName, rName2, rName3, "Updated", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "identity_info.0.first_name", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "phone_config.0.after_contact_work_time_limit", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUser_disappears(t *testing.T) {
+	var v connect.User
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName, rName2, rName3, "Disappear", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfconnect.ResourceUser(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserExists(resourceName string, v *connect.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect User not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect User ID not set")
+		}
+
+		instanceID, userID, err := tfconnect.UserParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+		output, err := tfconnect.FindUserByID(context.Background(), conn, instanceID, userID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckUserDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_user" {
+			continue
+		}
+
+		instanceID, userID, err := tfconnect.UserParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfconnect.FindUserByID(context.Background(), conn, instanceID, userID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect User %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccUserBaseConfig(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccRoutingProfileBaseConfig(rName, rName2),
+		fmt.Sprintf(`
+resource "aws_connect_routing_profile" "test" {
+  instance_id               = aws_connect_instance.test.id
+  name                      = %[1]q
+  description               = "Test Routing Profile"
+  default_outbound_queue_id = aws_connect_queue.test.queue_id
+
+  media_concurrencies {
+    channel     = "VOICE"
+    concurrency = 1
+  }
+}
+
+resource "aws_connect_security_profile" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  permissions = ["BasicAgentAccess"]
+}
+`, rName2))
+}
+
+func testAccUserConfig_basic(rName, rName2, rName3, label string, afterContactWorkTimeLimit int) string {
+	return acctest.ConfigCompose(
+		testAccUserBaseConfig(rName, rName2),
+		fmt.Sprintf(`
+resource "aws_connect_user" "test" {
+  instance_id          = aws_connect_instance.test.id
+  name                 = %[1]q
+  password             = "Password123"
+  routing_profile_id   = aws_connect_routing_profile.test.routing_profile_id
+  security_profile_ids = [aws_connect_security_profile.test.security_profile_id]
+
+  identity_info {
+    first_name = %[2]q
+    last_name  = "Test"
+  }
+
+  phone_config {
+    after_contact_work_time_limit = %[3]d
+    phone_type                    = "SOFT_PHONE"
+  }
+
+  tags = {
+    "Name" = "Test User"
+  }
+}
+`, rName3, label, afterContactWorkTimeLimit))
+}
@@ -37,7 +37,7 @@ func sweepReportDefinitions(region string) error {
 		}
 	}
 
-	conn := client.CURConn
+	conn := client.CURConn()
 
 	input := &cur.DescribeReportDefinitionsInput{}
 	var sweeperErrs *multierror.Error
@@ -0,0 +1,25 @@
+package detective
+
+import (
+	"fmt"
+	"strings"
+)
+
+const memberIDSeparator = "/"
+
+func MemberCreateResourceID(graphARN, accountID string) string {
+	parts := []string{graphARN, accountID}
+	id := strings.Join(parts, memberIDSeparator)
+
+	return id
+}
+
+func MemberParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, memberIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GRAPH-ARN%[2]sACCOUNT-ID", id, memberIDSeparator)
+}
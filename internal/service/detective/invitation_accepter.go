@@ -0,0 +1,102 @@
+package detective
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInvitationAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInvitationAccepterCreate,
+		Read:   resourceInvitationAccepterRead,
+		Delete: resourceInvitationAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceInvitationAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn()
+
+	graphARN := d.Get("graph_arn").(string)
+	input := &detective.AcceptInvitationInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	log.Printf("[DEBUG] Accepting Detective Invitation: %s", input)
+	_, err := conn.AcceptInvitation(input)
+
+	if err != nil {
+		return fmt.Errorf("error accepting Detective Invitation (%s): %w", graphARN, err)
+	}
+
+	d.SetId(graphARN)
+
+	return resourceInvitationAccepterRead(d, meta)
+}
+
+func resourceInvitationAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn()
+
+	member, err := FindInvitationByGraphARN(conn, d.Id())
+
+	if err == nil {
+		// An invitation that has not been accepted is not managed by this resource.
+		if status := aws.StringValue(member.Status); status != detective.MemberStatusEnabled && status != detective.MemberStatusAcceptedButDisabled {
+			err = &resource.NotFoundError{Message: fmt.Sprintf("invitation status: %s", status)}
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Invitation Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Detective Invitation Accepter (%s): %w", d.Id(), err)
+	}
+
+	d.Set("graph_arn", member.GraphArn)
+
+	return nil
+}
+
+func resourceInvitationAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn()
+
+	log.Printf("[DEBUG] Disassociating from Detective Graph: %s", d.Id())
+	_, err := conn.DisassociateMembership(&detective.DisassociateMembershipInput{
+		GraphArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating from Detective Graph (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package detective_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdetective "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccInvitationAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accepter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      acctest.CheckWithProviders(testAccCheckInvitationAccepterDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvitationAccepterConfig(acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvitationAccepterExists("aws_detective_member.test"),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.test", "id"),
+				),
+			},
+			{
+				Config:            testAccInvitationAccepterConfig(acctest.DefaultEmailAddress),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInvitationAccepterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*conns.AWSClient).DetectiveConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_invitation_accepter" {
+			continue
+		}
+
+		output, err := tfdetective.FindInvitationByGraphARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.Status); status != detective.MemberStatusEnabled && status != detective.MemberStatusAcceptedButDisabled {
+			continue
+		}
+
+		return fmt.Errorf("Detective Invitation Accepter %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccCheckInvitationAccepterExists verifies from the administrator account that the member has accepted.
+func testAccCheckInvitationAccepterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		graphARN, accountID, err := tfdetective.MemberParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn()
+
+		output, err := tfdetective.FindMemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.Status); status != detective.MemberStatusEnabled && status != detective.MemberStatusAcceptedButDisabled {
+			return fmt.Errorf("Detective Member (%s) has not accepted the invitation: %s", rs.Primary.ID, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccInvitationAccepterConfig(email string) string {
+	return acctest.ConfigCompose(testAccMemberConfig(email), `
+resource "aws_detective_invitation_accepter" "test" {
+  provider = "awsalternate"
+
+  graph_arn = aws_detective_member.test.graph_arn
+}
+`)
+}
@@ -0,0 +1,192 @@
+package detective
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMemberCreate,
+		Read:   resourceMemberRead,
+		Delete: resourceMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"administrator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disable_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"invited_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn()
+
+	accountID := d.Get("account_id").(string)
+	graphARN := d.Get("graph_arn").(string)
+	id := MemberCreateResourceID(graphARN, accountID)
+	input := &detective.CreateMembersInput{
+		Accounts: []*detective.Account{{
+			AccountId:    aws.String(accountID),
+			EmailAddress: aws.String(d.Get("email_address").(string)),
+		}},
+		GraphArn: aws.String(graphARN),
+	}
+
+	if v := d.Get("disable_email_notification").(bool); v {
+		input.DisableEmailNotification = aws.Bool(v)
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Detective Member: %s", input)
+	output, err := conn.CreateMembers(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Detective Member (%s): %w", id, err)
+	}
+
+	if output != nil && len(output.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("error creating Detective Member (%s): %s", id, aws.StringValue(output.UnprocessedAccounts[0].Reason))
+	}
+
+	d.SetId(id)
+
+	if _, err := waitMemberInvited(conn, graphARN, accountID); err != nil {
+		return fmt.Errorf("error waiting for Detective Member (%s) invite: %w", d.Id(), err)
+	}
+
+	return resourceMemberRead(d, meta)
+}
+
+func resourceMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn()
+
+	graphARN, accountID, err := MemberParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	member, err := FindMemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Detective Member (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", member.AccountId)
+	d.Set("administrator_id", member.AdministratorId)
+	d.Set("disabled_reason", member.DisabledReason)
+	d.Set("email_address", member.EmailAddress)
+	d.Set("graph_arn", member.GraphArn)
+	if member.InvitedTime != nil {
+		d.Set("invited_time", aws.TimeValue(member.InvitedTime).Format(time.RFC3339))
+	} else {
+		d.Set("invited_time", nil)
+	}
+	d.Set("status", member.Status)
+	if member.UpdatedTime != nil {
+		d.Set("updated_time", aws.TimeValue(member.UpdatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("updated_time", nil)
+	}
+
+	return nil
+}
+
+func resourceMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DetectiveConn()
+
+	graphARN, accountID, err := MemberParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Detective Member: %s", d.Id())
+	output, err := conn.DeleteMembers(&detective.DeleteMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+		GraphArn:   aws.String(graphARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Detective Member (%s): %w", d.Id(), err)
+	}
+
+	if output != nil && len(output.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("error deleting Detective Member (%s): %s", d.Id(), aws.StringValue(output.UnprocessedAccounts[0].Reason))
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package detective_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdetective "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccMember_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var member detective.MemberDetail
+	resourceName := "aws_detective_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberConfig(acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberExists(resourceName, &member),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.member", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "administrator_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", acctest.DefaultEmailAddress),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "invited_time"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusInvited),
+				),
+			},
+			{
+				Config:                  testAccMemberConfig(acctest.DefaultEmailAddress),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_email_notification"},
+			},
+		},
+	})
+}
+
+func testAccMember_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var member detective.MemberDetail
+	resourceName := "aws_detective_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberConfig(acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberExists(resourceName, &member),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdetective.ResourceMember(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccMember_message(t *testing.T) {
+	var providers []*schema.Provider
+	var member detective.MemberDetail
+	resourceName := "aws_detective_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberConfigMessage(acctest.DefaultEmailAddress, "This is a message of the invitation"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "message", "This is a message of the invitation"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusInvited),
+				),
+			},
+			{
+				Config:                  testAccMemberConfigMessage(acctest.DefaultEmailAddress, "This is a message of the invitation"),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_email_notification", "message"},
+			},
+		},
+	})
+}
+
+func testAccCheckMemberDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_member" {
+			continue
+		}
+
+		graphARN, accountID, err := tfdetective.MemberParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfdetective.FindMemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Detective Member %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckMemberExists(n string, v *detective.MemberDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Detective Member ID is set")
+		}
+
+		graphARN, accountID, err := tfdetective.MemberParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn()
+
+		output, err := tfdetective.FindMemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMemberBaseConfig() string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), `
+data "aws_caller_identity" "current" {}
+
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "test" {}
+`)
+}
+
+func testAccMemberConfig(email string) string {
+	return acctest.ConfigCompose(testAccMemberBaseConfig(), fmt.Sprintf(`
+resource "aws_detective_member" "test" {
+  account_id    = data.aws_caller_identity.member.account_id
+  graph_arn     = aws_detective_graph.test.id
+  email_address = %[1]q
+}
+`, email))
+}
+
+func testAccMemberConfigMessage(email, message string) string {
+	return acctest.ConfigCompose(testAccMemberBaseConfig(), fmt.Sprintf(`
+resource "aws_detective_member" "test" {
+  account_id                 = data.aws_caller_identity.member.account_id
+  graph_arn                  = aws_detective_graph.test.id
+  email_address              = %[1]q
+  message                    = %[2]q
+  disable_email_notification = true
+}
+`, email, message))
+}
@@ -0,0 +1,68 @@
+//go:build sweep
+// +build sweep
+
+package detective
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_detective_graph", &resource.Sweeper{
+		Name: "aws_detective_graph",
+		F:    sweepGraphs,
+	})
+}
+
+func sweepGraphs(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).DetectiveConn()
+	input := &detective.ListGraphsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListGraphsPages(input, func(page *detective.ListGraphsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GraphList {
+			r := ResourceGraph()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Detective Graph sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Detective Graphs (%s): %w", region, err)
+	}
+
+	var errs *multierror.Error
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Detective Graphs (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,81 @@
+package detective
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	memberInvitedTimeout = 4 * time.Minute
+
+	organizationAdminAccountEnabledTimeout  = 5 * time.Minute
+	organizationAdminAccountDisabledTimeout = 5 * time.Minute
+
+	// organizationAdminAccountStatusEnabled is the status of a delegated administrator that is listed,
+	// the API has no status for delegated administrators.
+	organizationAdminAccountStatusEnabled = "Enabled"
+)
+
+// waitMemberInvited waits for e-mail verification of a newly created member to finish.
+func waitMemberInvited(conn *detective.Detective, graphARN, accountID string) (*detective.MemberDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{detective.MemberStatusVerificationInProgress},
+		Target:  []string{detective.MemberStatusInvited, detective.MemberStatusEnabled, detective.MemberStatusAcceptedButDisabled},
+		Refresh: statusMember(conn, graphARN, accountID),
+		Timeout: memberInvitedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*detective.MemberDetail); ok {
+		if status := aws.StringValue(output.Status); status == detective.MemberStatusVerificationFailed {
+			tfresource.SetLastError(err, errors.New("e-mail verification failed"))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitOrganizationAdminAccountEnabled waits for a newly enabled delegated administrator to be listed.
+func waitOrganizationAdminAccountEnabled(conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    []string{organizationAdminAccountStatusEnabled},
+		Refresh:                   statusOrganizationAdminAccount(conn, accountID),
+		Timeout:                   organizationAdminAccountEnabledTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*detective.Administrator); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitOrganizationAdminAccountDisabled waits for a disabled delegated administrator to no longer be listed.
+func waitOrganizationAdminAccountDisabled(conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizationAdminAccountStatusEnabled},
+		Target:  []string{},
+		Refresh: statusOrganizationAdminAccount(conn, accountID),
+		Timeout: organizationAdminAccountDisabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*detective.Administrator); ok {
+		return output, err
+	}
+
+	return nil, err
+}
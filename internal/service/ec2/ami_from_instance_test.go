@@ -118,7 +118,7 @@ func testAccCheckAMIFromInstanceExists(resourceName string, image *ec2.Image) re
 			return fmt.Errorf("No ID set for %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		input := &ec2.DescribeImagesInput{
 			ImageIds: []*string{aws.String(rs.Primary.ID)},
@@ -139,7 +139,7 @@ func testAccCheckAMIFromInstanceExists(resourceName string, image *ec2.Image) re
 }
 
 func testAccCheckAMIFromInstanceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ami_from_instance" {
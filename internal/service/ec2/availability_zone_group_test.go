@@ -56,7 +56,7 @@ func TestAccEC2AvailabilityZoneGroup_optInStatus(t *testing.T) {
 }
 
 func testAccPreCheckAvailabilityZoneGroup(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeAvailabilityZonesInput{
 		AllAvailabilityZones: aws.Bool(true),
@@ -106,7 +106,7 @@ func testAccClientVPNRoute_disappears(t *testing.T) {
 }
 
 func testAccCheckClientVPNRouteDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_client_vpn_route" {
@@ -135,7 +135,7 @@ func testAccCheckClientVPNRouteExists(name string, route *ec2.ClientVpnRoute) re
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		resp, err := tfec2.FindClientVPNRouteByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -57,7 +57,7 @@ func ResourceDefaultSubnet() *schema.Resource {
 }
 
 func resourceDefaultSubnetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeSubnetsInput{}
 	req.Filters = BuildAttributeFilterList(
@@ -21,6 +21,9 @@ func ResourceDefaultVPC() *schema.Resource {
 		Type:     schema.TypeString,
 		Computed: true,
 	}
+	// IPAM pool allocation is not supported for Default VPCs
+	delete(dvpc.Schema, "ipv4_ipam_pool_id")
+	delete(dvpc.Schema, "ipv4_netmask_length")
 	// instance_tenancy is a computed value for Default VPCs
 	dvpc.Schema["instance_tenancy"] = &schema.Schema{
 		Type:     schema.TypeString,
@@ -36,7 +39,7 @@ func ResourceDefaultVPC() *schema.Resource {
 }
 
 func resourceDefaultVPCCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	req := &ec2.DescribeVpcsInput{
 		Filters: []*ec2.Filter{
 			{
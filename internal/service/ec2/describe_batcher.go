@@ -0,0 +1,369 @@
+package ec2
+
+import (
+	"errors"
+	"log"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+const (
+	// describeBatchWindow is how long the first lookup of a batch waits for concurrent lookups to join it.
+	describeBatchWindow = 100 * time.Millisecond
+	// describeBatchMaxIDs is the maximum number of IDs sent in a single Describe call.
+	describeBatchMaxIDs = 200
+
+	describeBatchOperationInstances      = "DescribeInstances"
+	describeBatchOperationSecurityGroups = "DescribeSecurityGroups"
+	describeBatchOperationSubnets        = "DescribeSubnets"
+)
+
+// describeBatchers holds the describeBatcher of each provider instance's EC2 connection that has batching enabled.
+var describeBatchers sync.Map
+
+// EnableDescribeBatching enables the coalescing of the ID-based lookups made while refreshing aws_instance,
+// aws_security_group, aws_default_security_group and aws_subnet resources into Describe calls with many IDs
+// for the specified connection.
+// Other lookups, such as those made while waiting for a resource's state to change, are not batched.
+func EnableDescribeBatching(conn *ec2.EC2) {
+	describeBatchers.LoadOrStore(conn, newDescribeBatcher(describeBatchWindow))
+}
+
+// describeBatcherFor returns the specified connection's describeBatcher, or nil if batching is not enabled.
+func describeBatcherFor(conn *ec2.EC2) *describeBatcher {
+	v, ok := describeBatchers.Load(conn)
+
+	if !ok {
+		return nil
+	}
+
+	return v.(*describeBatcher)
+}
+
+// findInstanceByIDForRead looks up an instance by ID for resourceInstanceRead, in a batch if batching is enabled.
+func findInstanceByIDForRead(conn *ec2.EC2, id string) (*ec2.Instance, error) {
+	if batcher := describeBatcherFor(conn); batcher != nil {
+		v, ok, err := batcher.Get(describeBatchOperationInstances, id, describeInstancesBatch(conn))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return v.(*ec2.Instance), nil
+		}
+	}
+
+	return InstanceFindByID(conn, id)
+}
+
+// findSecurityGroupByIDForRead looks up a security group by ID for security group Read functions, in a batch if batching is enabled.
+func findSecurityGroupByIDForRead(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
+	if batcher := describeBatcherFor(conn); batcher != nil {
+		v, ok, err := batcher.Get(describeBatchOperationSecurityGroups, id, describeSecurityGroupsBatch(conn))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return v.(*ec2.SecurityGroup), nil
+		}
+	}
+
+	return FindSecurityGroupByID(conn, id)
+}
+
+// findSubnetByIDForRead looks up a subnet by ID for resourceSubnetRead, in a batch if batching is enabled.
+func findSubnetByIDForRead(conn *ec2.EC2, id string) (*ec2.Subnet, error) {
+	if batcher := describeBatcherFor(conn); batcher != nil {
+		v, ok, err := batcher.Get(describeBatchOperationSubnets, id, describeSubnetsBatch(conn))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return v.(*ec2.Subnet), nil
+		}
+	}
+
+	return FindSubnetByID(conn, id)
+}
+
+// describeBatchFunc describes the resources with the specified IDs and returns them keyed by ID.
+type describeBatchFunc func(ids []string) (map[string]interface{}, error)
+
+// describeBatcher collects the ID-based lookups issued within a short window into one Describe call per operation.
+// A lookup that is not answered by a batch, because the batch has a single ID or the ID does not exist,
+// is left to the caller's own single-ID lookup, so that NotFound semantics are unchanged.
+// IDs reported as not found by a failed Describe call are removed and the call is sent again with the others.
+// A throttling error is returned to every lookup of the batch, any other error leaves the lookups to the callers.
+type describeBatcher struct {
+	mu      sync.Mutex
+	batches map[string]*describeBatch
+	window  time.Duration
+}
+
+type describeBatch struct {
+	describe describeBatchFunc
+	waiters  map[string][]chan describeBatchResult
+}
+
+type describeBatchResult struct {
+	value interface{}
+	err   error
+}
+
+func newDescribeBatcher(window time.Duration) *describeBatcher {
+	return &describeBatcher{
+		batches: make(map[string]*describeBatch),
+		window:  window,
+	}
+}
+
+// Get returns the resource with the specified ID, described as part of the next batch for the operation,
+// and whether the batch answered the lookup.
+func (b *describeBatcher) Get(operation, id string, describe describeBatchFunc) (interface{}, bool, error) {
+	result := make(chan describeBatchResult, 1)
+
+	b.mu.Lock()
+
+	batch := b.batches[operation]
+
+	if _, ok := batch.waiterFor(id); batch != nil && !ok && len(batch.waiters) >= describeBatchMaxIDs {
+		delete(b.batches, operation)
+		go batch.send(operation)
+		batch = nil
+	}
+
+	if batch == nil {
+		batch = &describeBatch{
+			describe: describe,
+			waiters:  make(map[string][]chan describeBatchResult),
+		}
+		b.batches[operation] = batch
+
+		time.AfterFunc(b.window, func() {
+			b.flush(operation, batch)
+		})
+	}
+
+	batch.waiters[id] = append(batch.waiters[id], result)
+
+	b.mu.Unlock()
+
+	v := <-result
+
+	return v.value, v.value != nil, v.err
+}
+
+// flush sends the specified batch, unless it has already been sent.
+func (b *describeBatcher) flush(operation string, batch *describeBatch) {
+	b.mu.Lock()
+
+	if b.batches[operation] != batch {
+		b.mu.Unlock()
+		return
+	}
+
+	delete(b.batches, operation)
+
+	b.mu.Unlock()
+
+	batch.send(operation)
+}
+
+func (batch *describeBatch) waiterFor(id string) ([]chan describeBatchResult, bool) {
+	if batch == nil {
+		return nil, false
+	}
+
+	v, ok := batch.waiters[id]
+
+	return v, ok
+}
+
+func (batch *describeBatch) send(operation string) {
+	results, err := batch.results(operation)
+
+	for id, waiters := range batch.waiters {
+		for _, waiter := range waiters {
+			waiter <- describeBatchResult{value: results[id], err: err}
+		}
+	}
+}
+
+// results describes the batch's IDs, sending the Describe call again without the IDs it reports as not found.
+func (batch *describeBatch) results(operation string) (map[string]interface{}, error) {
+	// A single lookup is left to the caller, which makes the same call without the batching overhead.
+	if len(batch.waiters) <= 1 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(batch.waiters))
+
+	for id := range batch.waiters {
+		ids = append(ids, id)
+	}
+
+	for len(ids) > 1 {
+		log.Printf("[DEBUG] Batching EC2 %s: %d IDs", operation, len(ids))
+		results, err := batch.describe(ids)
+
+		if err == nil {
+			return results, nil
+		}
+
+		if request.IsErrorThrottle(err) {
+			return nil, err
+		}
+
+		// e.g. a malformed ID, which the caller's single-ID lookup reports for that ID only.
+		if !tfawserr.ErrCodeEquals(err, describeBatchNotFoundErrCodes[operation]...) {
+			log.Printf("[DEBUG] Batched EC2 %s failed, falling back to single lookups: %s", operation, err)
+			return nil, nil
+		}
+
+		found := describeBatchFoundIDs(ids, err)
+
+		// The missing IDs cannot be identified, leave all lookups to the callers.
+		if len(found) == len(ids) {
+			log.Printf("[DEBUG] Batched EC2 %s failed, falling back to single lookups: %s", operation, err)
+			return nil, nil
+		}
+
+		ids = found
+	}
+
+	return nil, nil
+}
+
+// describeBatchNotFoundErrCodes are the error codes returned by each operation when some of the IDs do not exist.
+var describeBatchNotFoundErrCodes = map[string][]string{
+	describeBatchOperationInstances:      {ErrCodeInvalidInstanceIDNotFound},
+	describeBatchOperationSecurityGroups: {InvalidGroupNotFound, InvalidSecurityGroupIDNotFound},
+	describeBatchOperationSubnets:        {ErrCodeInvalidSubnetIDNotFound},
+}
+
+// describeBatchFoundIDs returns the IDs not listed in the message of a NotFound error,
+// e.g. "The instance IDs 'i-1, i-2' do not exist".
+func describeBatchFoundIDs(ids []string, err error) []string {
+	var awsErr awserr.Error
+
+	if !errors.As(err, &awsErr) {
+		return ids
+	}
+
+	missing := make(map[string]bool)
+
+	for _, v := range describeBatchIDPattern.FindAllString(awsErr.Message(), -1) {
+		missing[v] = true
+	}
+
+	var found []string
+
+	for _, id := range ids {
+		if !missing[id] {
+			found = append(found, id)
+		}
+	}
+
+	return found
+}
+
+var describeBatchIDPattern = regexp.MustCompile(`\b[a-z]+-[0-9a-f]+\b`)
+
+func describeInstancesBatch(conn *ec2.EC2) describeBatchFunc {
+	return func(ids []string) (map[string]interface{}, error) {
+		input := &ec2.DescribeInstancesInput{
+			InstanceIds: aws.StringSlice(ids),
+		}
+		results := make(map[string]interface{}, len(ids))
+
+		err := conn.DescribeInstancesPages(input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, reservation := range page.Reservations {
+				if reservation == nil {
+					continue
+				}
+
+				for _, instance := range reservation.Instances {
+					if instance == nil {
+						continue
+					}
+
+					results[aws.StringValue(instance.InstanceId)] = instance
+				}
+			}
+
+			return !lastPage
+		})
+
+		return results, err
+	}
+}
+
+func describeSecurityGroupsBatch(conn *ec2.EC2) describeBatchFunc {
+	return func(ids []string) (map[string]interface{}, error) {
+		input := &ec2.DescribeSecurityGroupsInput{
+			GroupIds: aws.StringSlice(ids),
+		}
+		results := make(map[string]interface{}, len(ids))
+
+		err := conn.DescribeSecurityGroupsPages(input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, securityGroup := range page.SecurityGroups {
+				if securityGroup == nil {
+					continue
+				}
+
+				results[aws.StringValue(securityGroup.GroupId)] = securityGroup
+			}
+
+			return !lastPage
+		})
+
+		return results, err
+	}
+}
+
+func describeSubnetsBatch(conn *ec2.EC2) describeBatchFunc {
+	return func(ids []string) (map[string]interface{}, error) {
+		input := &ec2.DescribeSubnetsInput{
+			SubnetIds: aws.StringSlice(ids),
+		}
+		results := make(map[string]interface{}, len(ids))
+
+		err := conn.DescribeSubnetsPages(input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, subnet := range page.Subnets {
+				if subnet == nil {
+					continue
+				}
+
+				results[aws.StringValue(subnet.SubnetId)] = subnet
+			}
+
+			return !lastPage
+		})
+
+		return results, err
+	}
+}
@@ -0,0 +1,262 @@
+package ec2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// testDescribeBatch is a fake describeBatchFunc recording the IDs of each call.
+// IDs listed in missing are left out of the results, any call including an ID listed in fail returns a NotFound error
+// listing those IDs, any call including an ID listed in malformed returns a Malformed error
+// and every call returns a throttling error if throttle is set.
+type testDescribeBatch struct {
+	sync.Mutex
+
+	calls     [][]string
+	fail      map[string]bool
+	malformed map[string]bool
+	missing   map[string]bool
+	throttle  bool
+}
+
+func (f *testDescribeBatch) describe(ids []string) (map[string]interface{}, error) {
+	f.Lock()
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+	f.calls = append(f.calls, sorted)
+	f.Unlock()
+
+	if f.throttle {
+		return nil, awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil)
+	}
+
+	results := make(map[string]interface{}, len(ids))
+	var notFound []string
+
+	for _, id := range sorted {
+		if f.malformed[id] {
+			return nil, awserr.New("InvalidInstanceID.Malformed", fmt.Sprintf("Invalid id: \"%s\"", id), nil)
+		}
+
+		if f.fail[id] {
+			notFound = append(notFound, id)
+			continue
+		}
+
+		if f.missing[id] {
+			continue
+		}
+
+		results[id] = &ec2.Instance{InstanceId: aws.String(id)}
+	}
+
+	if len(notFound) > 0 {
+		return nil, awserr.New(ErrCodeInvalidInstanceIDNotFound, fmt.Sprintf("The instance IDs '%s' do not exist", strings.Join(notFound, ", ")), nil)
+	}
+
+	return results, nil
+}
+
+func TestDescribeBatcher(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		IDs            []string
+		Fail           []string
+		Malformed      []string
+		Missing        []string
+		Throttle       bool
+		ExpectedCalls  int
+		ExpectedFound  []string
+		ExpectedErrors int
+	}{
+		{
+			Name:          "single lookup",
+			IDs:           []string{"i-1"},
+			ExpectedCalls: 0,
+		},
+		{
+			Name:          "concurrent lookups",
+			IDs:           []string{"i-1", "i-2", "i-3"},
+			ExpectedCalls: 1,
+			ExpectedFound: []string{"i-1", "i-2", "i-3"},
+		},
+		{
+			Name:          "duplicate lookups",
+			IDs:           []string{"i-1", "i-1", "i-2"},
+			ExpectedCalls: 1,
+			ExpectedFound: []string{"i-1", "i-1", "i-2"},
+		},
+		{
+			Name:          "not found ID",
+			IDs:           []string{"i-1", "i-2", "i-3"},
+			Fail:          []string{"i-2"},
+			ExpectedCalls: 2,
+			ExpectedFound: []string{"i-1", "i-3"},
+		},
+		{
+			Name:          "not found IDs",
+			IDs:           []string{"i-1", "i-2", "i-3"},
+			Fail:          []string{"i-1", "i-2"},
+			ExpectedCalls: 1,
+		},
+		{
+			Name:           "throttled call",
+			IDs:            []string{"i-1", "i-2", "i-3"},
+			Throttle:       true,
+			ExpectedCalls:  1,
+			ExpectedErrors: 3,
+		},
+		{
+			Name:          "malformed ID",
+			IDs:           []string{"i-1", "i-2", "i-3"},
+			Malformed:     []string{"i-2"},
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "missing ID",
+			IDs:           []string{"i-1", "i-2", "i-3"},
+			Missing:       []string{"i-3"},
+			ExpectedCalls: 1,
+			ExpectedFound: []string{"i-1", "i-2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			f := &testDescribeBatch{
+				fail:      make(map[string]bool),
+				malformed: make(map[string]bool),
+				missing:   make(map[string]bool),
+				throttle:  testCase.Throttle,
+			}
+			for _, id := range testCase.Fail {
+				f.fail[id] = true
+			}
+			for _, id := range testCase.Malformed {
+				f.malformed[id] = true
+			}
+			for _, id := range testCase.Missing {
+				f.missing[id] = true
+			}
+
+			batcher := newDescribeBatcher(100 * time.Millisecond)
+
+			var wg sync.WaitGroup
+			found := make([]bool, len(testCase.IDs))
+			errs := make([]error, len(testCase.IDs))
+
+			for i, id := range testCase.IDs {
+				wg.Add(1)
+
+				go func(i int, id string) {
+					defer wg.Done()
+
+					v, ok, err := batcher.Get(describeBatchOperationInstances, id, f.describe)
+
+					if ok {
+						if got := aws.StringValue(v.(*ec2.Instance).InstanceId); got != id {
+							t.Errorf("got instance %s, want %s", got, id)
+						}
+					}
+					found[i] = ok
+					errs[i] = err
+				}(i, id)
+			}
+
+			wg.Wait()
+
+			if got, want := len(f.calls), testCase.ExpectedCalls; got != want {
+				t.Errorf("got %d Describe calls (%v), want %d", got, f.calls, want)
+			}
+
+			var gotErrors int
+			for _, err := range errs {
+				if err != nil {
+					gotErrors++
+				}
+			}
+
+			if got, want := gotErrors, testCase.ExpectedErrors; got != want {
+				t.Errorf("got %d lookups with errors, want %d", got, want)
+			}
+
+			var gotFound []string
+			for i, ok := range found {
+				if ok {
+					gotFound = append(gotFound, testCase.IDs[i])
+				}
+			}
+			sort.Strings(gotFound)
+
+			if got, want := fmt.Sprint(gotFound), fmt.Sprint(testCase.ExpectedFound); got != want {
+				t.Errorf("got lookups answered by the batch %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDescribeBatcherMaxIDs(t *testing.T) {
+	f := &testDescribeBatch{}
+	batcher := newDescribeBatcher(200 * time.Millisecond)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < describeBatchMaxIDs+1; i++ {
+		wg.Add(1)
+
+		go func(id string) {
+			defer wg.Done()
+
+			batcher.Get(describeBatchOperationInstances, id, f.describe)
+		}(fmt.Sprintf("i-%d", i))
+	}
+
+	wg.Wait()
+
+	// The remaining single lookup is left to the caller.
+	if got, want := len(f.calls), 1; got != want {
+		t.Fatalf("got %d Describe calls, want %d", got, want)
+	}
+
+	if got, want := len(f.calls[0]), describeBatchMaxIDs; got != want {
+		t.Errorf("got %d IDs in Describe call, want %d", got, want)
+	}
+}
+
+func TestDescribeBatcherFor(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String("us-east-1")}))
+	conn := ec2.New(sess)
+
+	if describeBatcherFor(conn) != nil {
+		t.Fatal("expected no batcher before enabling batching")
+	}
+
+	EnableDescribeBatching(conn)
+	defer describeBatchers.Delete(conn)
+
+	batcher := describeBatcherFor(conn)
+
+	if batcher == nil {
+		t.Fatal("expected batcher after enabling batching")
+	}
+
+	EnableDescribeBatching(conn)
+
+	if describeBatcherFor(conn) != batcher {
+		t.Error("expected enabling batching twice to keep the same batcher")
+	}
+
+	if describeBatcherFor(ec2.New(sess)) != nil {
+		t.Error("expected no batcher for another connection")
+	}
+}
@@ -12,6 +12,7 @@ import (
 const (
 	ErrCodeDependencyViolation          = "DependencyViolation"
 	ErrCodeGatewayNotAttached           = "Gateway.NotAttached"
+	ErrCodeIncorrectState               = "IncorrectState"
 	ErrCodeInvalidAssociationIDNotFound = "InvalidAssociationID.NotFound"
 	ErrCodeInvalidAttachmentIDNotFound  = "InvalidAttachmentID.NotFound"
 	ErrCodeInvalidKeyPairNotFound       = "InvalidKeyPair.NotFound"
@@ -33,6 +34,18 @@ const (
 	ErrCodeInvalidInternetGatewayIDNotFound = "InvalidInternetGatewayID.NotFound"
 )
 
+const (
+	ErrCodeInvalidNetworkInsightsAnalysisIdNotFound = "InvalidNetworkInsightsAnalysisId.NotFound"
+	ErrCodeInvalidNetworkInsightsPathIdNotFound     = "InvalidNetworkInsightsPathId.NotFound"
+)
+
+const (
+	ErrCodeInvalidIPAMIdNotFound               = "InvalidIpamId.NotFound"
+	ErrCodeInvalidIPAMPoolAllocationIdNotFound = "InvalidIpamPoolAllocationId.NotFound"
+	ErrCodeInvalidIPAMPoolIdNotFound           = "InvalidIpamPoolId.NotFound"
+	ErrCodeInvalidIPAMScopeIdNotFound          = "InvalidIpamScopeId.NotFound"
+)
+
 const (
 	ErrCodeInvalidNetworkInterfaceIDNotFound = "InvalidNetworkInterfaceID.NotFound"
 )
@@ -67,6 +80,10 @@ const (
 	InvalidGroupNotFound           = "InvalidGroup.NotFound"
 )
 
+const (
+	ErrCodeInvalidSecurityGroupRuleIdNotFound = "InvalidSecurityGroupRuleId.NotFound"
+)
+
 const (
 	ErrCodeInvalidSpotInstanceRequestIDNotFound = "InvalidSpotInstanceRequestID.NotFound"
 )
@@ -71,6 +71,42 @@ func ClientVPNRouteParseID(id string) (string, string, string, error) {
 			"target-subnet-id"+clientVpnRouteIDSeparator+"destination-cidr-block", id)
 }
 
+const ipamPoolCIDRIDSeparator = "_"
+
+func IPAMPoolCIDRCreateID(cidrBlock, poolID string) string {
+	parts := []string{cidrBlock, poolID}
+	id := strings.Join(parts, ipamPoolCIDRIDSeparator)
+	return id
+}
+
+func IPAMPoolCIDRParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ipamPoolCIDRIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cidr%[2]sipam-pool-id", id, ipamPoolCIDRIDSeparator)
+}
+
+const ipamPoolCIDRAllocationIDSeparator = "_"
+
+func IPAMPoolCIDRAllocationCreateID(allocationID, poolID string) string {
+	parts := []string{allocationID, poolID}
+	id := strings.Join(parts, ipamPoolCIDRAllocationIDSeparator)
+	return id
+}
+
+func IPAMPoolCIDRAllocationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ipamPoolCIDRAllocationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ipam-pool-allocation-id%[2]sipam-pool-id", id, ipamPoolCIDRAllocationIDSeparator)
+}
+
 const managedPrefixListEntryIDSeparator = ","
 
 func ManagedPrefixListEntryCreateID(prefixListID, cidrBlock string) string {
@@ -85,7 +85,7 @@ func testAccCheckLocalGatewayRouteExists(resourceName string) resource.TestCheck
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		route, err := tfec2.GetLocalGatewayRoute(conn, localGatewayRouteTableID, destination)
 
@@ -102,7 +102,7 @@ func testAccCheckLocalGatewayRouteExists(resourceName string) resource.TestCheck
 }
 
 func testAccCheckLocalGatewayRouteDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_local_gateway_route" {
@@ -0,0 +1,837 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkInsightsAnalysis() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkInsightsAnalysisCreate,
+		Read:   resourceNetworkInsightsAnalysisRead,
+		Update: resourceNetworkInsightsAnalysisUpdate,
+		Delete: resourceNetworkInsightsAnalysisDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alternate_path_hints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"explanations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"acl":      networkInsightsAnalysisComponentSchema(),
+						"acl_rule": networkInsightsAnalysisACLRuleSchema(),
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"attached_to": networkInsightsAnalysisComponentSchema(),
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cidrs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"classic_load_balancer_listener": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"load_balancer_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"component":        networkInsightsAnalysisComponentSchema(),
+						"customer_gateway": networkInsightsAnalysisComponentSchema(),
+						"destination":      networkInsightsAnalysisComponentSchema(),
+						"destination_vpc":  networkInsightsAnalysisComponentSchema(),
+						"direction": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"elastic_load_balancer_listener": networkInsightsAnalysisComponentSchema(),
+						"explanation_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingress_route_table": networkInsightsAnalysisComponentSchema(),
+						"internet_gateway":    networkInsightsAnalysisComponentSchema(),
+						"load_balancer_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"load_balancer_listener_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"load_balancer_target": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"instance": networkInsightsAnalysisComponentSchema(),
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"load_balancer_target_group":  networkInsightsAnalysisComponentSchema(),
+						"load_balancer_target_groups": networkInsightsAnalysisComponentSchema(),
+						"load_balancer_target_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"missing_component": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nat_gateway":       networkInsightsAnalysisComponentSchema(),
+						"network_interface": networkInsightsAnalysisComponentSchema(),
+						"packet_field": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"port_ranges": networkInsightsAnalysisPortRangeSchema(),
+						"prefix_list": networkInsightsAnalysisComponentSchema(),
+						"protocols": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"route_table":         networkInsightsAnalysisComponentSchema(),
+						"route_table_route":   networkInsightsAnalysisRouteTableRouteSchema(),
+						"security_group":      networkInsightsAnalysisComponentSchema(),
+						"security_group_rule": networkInsightsAnalysisSecurityGroupRuleSchema(),
+						"security_groups":     networkInsightsAnalysisComponentSchema(),
+						"source_vpc":          networkInsightsAnalysisComponentSchema(),
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet":                 networkInsightsAnalysisComponentSchema(),
+						"subnet_route_table":     networkInsightsAnalysisComponentSchema(),
+						"vpc":                    networkInsightsAnalysisComponentSchema(),
+						"vpc_endpoint":           networkInsightsAnalysisComponentSchema(),
+						"vpc_peering_connection": networkInsightsAnalysisComponentSchema(),
+						"vpn_connection":         networkInsightsAnalysisComponentSchema(),
+						"vpn_gateway":            networkInsightsAnalysisComponentSchema(),
+					},
+				},
+			},
+			"filter_in_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"forward_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"network_insights_path_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path_found": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"return_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceNetworkInsightsAnalysisCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.StartNetworkInsightsAnalysisInput{
+		ClientToken:           aws.String(resource.UniqueId()),
+		NetworkInsightsPathId: aws.String(d.Get("network_insights_path_id").(string)),
+		TagSpecifications:     ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNetworkInsightsAnalysis),
+	}
+
+	if v, ok := d.GetOk("filter_in_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.FilterInArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Insights Analysis: %s", input)
+	output, err := conn.StartNetworkInsightsAnalysis(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Network Insights Analysis: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := WaitNetworkInsightsAnalysisCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 Network Insights Analysis (%s) create: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(d, meta)
+}
+
+func resourceNetworkInsightsAnalysisRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindNetworkInsightsAnalysisByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Analysis (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Network Insights Analysis (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("alternate_path_hints", flattenAlternatePathHints(output.AlternatePathHints)); err != nil {
+		return fmt.Errorf("error setting alternate_path_hints: %w", err)
+	}
+
+	d.Set("arn", output.NetworkInsightsAnalysisArn)
+
+	if err := d.Set("explanations", flattenExplanations(output.Explanations)); err != nil {
+		return fmt.Errorf("error setting explanations: %w", err)
+	}
+
+	if err := d.Set("filter_in_arns", aws.StringValueSlice(output.FilterInArns)); err != nil {
+		return fmt.Errorf("error setting filter_in_arns: %w", err)
+	}
+
+	if err := d.Set("forward_path_components", flattenPathComponents(output.ForwardPathComponents)); err != nil {
+		return fmt.Errorf("error setting forward_path_components: %w", err)
+	}
+
+	d.Set("network_insights_path_id", output.NetworkInsightsPathId)
+	d.Set("path_found", output.NetworkPathFound)
+
+	if err := d.Set("return_path_components", flattenPathComponents(output.ReturnPathComponents)); err != nil {
+		return fmt.Errorf("error setting return_path_components: %w", err)
+	}
+
+	if output.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(output.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkInsightsAnalysisUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Network Insights Analysis (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(d, meta)
+}
+
+func resourceNetworkInsightsAnalysisDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Analysis: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsAnalysis(&ec2.DeleteNetworkInsightsAnalysisInput{
+		NetworkInsightsAnalysisId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidNetworkInsightsAnalysisIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Network Insights Analysis (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func networkInsightsAnalysisComponentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisPortRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"to": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisACLRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"egress": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"port_range": networkInsightsAnalysisPortRangeSchema(),
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rule_action": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rule_number": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisPacketHeaderSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_addresses": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"destination_port_ranges": networkInsightsAnalysisPortRangeSchema(),
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"source_addresses": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"source_port_ranges": networkInsightsAnalysisPortRangeSchema(),
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisRouteTableRouteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_cidr": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"destination_prefix_list_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"egress_only_internet_gateway_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"gateway_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"instance_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"nat_gateway_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"network_interface_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"origin": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"transit_gateway_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"vpc_peering_connection_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisSecurityGroupRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"direction": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port_range": networkInsightsAnalysisPortRangeSchema(),
+				"prefix_list_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"security_group_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networkInsightsAnalysisPathComponentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"acl_rule":            networkInsightsAnalysisACLRuleSchema(),
+				"component":           networkInsightsAnalysisComponentSchema(),
+				"destination_vpc":     networkInsightsAnalysisComponentSchema(),
+				"inbound_header":      networkInsightsAnalysisPacketHeaderSchema(),
+				"outbound_header":     networkInsightsAnalysisPacketHeaderSchema(),
+				"route_table_route":   networkInsightsAnalysisRouteTableRouteSchema(),
+				"security_group_rule": networkInsightsAnalysisSecurityGroupRuleSchema(),
+				"sequence_number": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"source_vpc": networkInsightsAnalysisComponentSchema(),
+				"subnet":     networkInsightsAnalysisComponentSchema(),
+				"vpc":        networkInsightsAnalysisComponentSchema(),
+			},
+		},
+	}
+}
+
+func flattenAlternatePathHints(apiObjects []*ec2.AlternatePathHint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"component_arn": aws.StringValue(apiObject.ComponentArn),
+			"component_id":  aws.StringValue(apiObject.ComponentId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAnalysisComponent(apiObject *ec2.AnalysisComponent) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn": aws.StringValue(apiObject.Arn),
+		"id":  aws.StringValue(apiObject.Id),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisComponents(apiObjects []*ec2.AnalysisComponent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenAnalysisComponent(apiObject)...)
+	}
+
+	return tfList
+}
+
+func flattenPortRange(apiObject *ec2.PortRange) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"from": aws.Int64Value(apiObject.From),
+		"to":   aws.Int64Value(apiObject.To),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPortRanges(apiObjects []*ec2.PortRange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenPortRange(apiObject)...)
+	}
+
+	return tfList
+}
+
+func flattenAnalysisACLRule(apiObject *ec2.AnalysisAclRule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cidr":        aws.StringValue(apiObject.Cidr),
+		"egress":      aws.BoolValue(apiObject.Egress),
+		"port_range":  flattenPortRange(apiObject.PortRange),
+		"protocol":    aws.StringValue(apiObject.Protocol),
+		"rule_action": aws.StringValue(apiObject.RuleAction),
+		"rule_number": aws.Int64Value(apiObject.RuleNumber),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisLoadBalancerListener(apiObject *ec2.AnalysisLoadBalancerListener) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"instance_port":      aws.Int64Value(apiObject.InstancePort),
+		"load_balancer_port": aws.Int64Value(apiObject.LoadBalancerPort),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisLoadBalancerTarget(apiObject *ec2.AnalysisLoadBalancerTarget) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"address":           aws.StringValue(apiObject.Address),
+		"availability_zone": aws.StringValue(apiObject.AvailabilityZone),
+		"instance":          flattenAnalysisComponent(apiObject.Instance),
+		"port":              aws.Int64Value(apiObject.Port),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisPacketHeader(apiObject *ec2.AnalysisPacketHeader) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination_addresses":   aws.StringValueSlice(apiObject.DestinationAddresses),
+		"destination_port_ranges": flattenPortRanges(apiObject.DestinationPortRanges),
+		"protocol":                aws.StringValue(apiObject.Protocol),
+		"source_addresses":        aws.StringValueSlice(apiObject.SourceAddresses),
+		"source_port_ranges":      flattenPortRanges(apiObject.SourcePortRanges),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisRouteTableRoute(apiObject *ec2.AnalysisRouteTableRoute) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination_cidr":                aws.StringValue(apiObject.DestinationCidr),
+		"destination_prefix_list_id":      aws.StringValue(apiObject.DestinationPrefixListId),
+		"egress_only_internet_gateway_id": aws.StringValue(apiObject.EgressOnlyInternetGatewayId),
+		"gateway_id":                      aws.StringValue(apiObject.GatewayId),
+		"instance_id":                     aws.StringValue(apiObject.InstanceId),
+		"nat_gateway_id":                  aws.StringValue(apiObject.NatGatewayId),
+		"network_interface_id":            aws.StringValue(apiObject.NetworkInterfaceId),
+		"origin":                          aws.StringValue(apiObject.Origin),
+		"transit_gateway_id":              aws.StringValue(apiObject.TransitGatewayId),
+		"vpc_peering_connection_id":       aws.StringValue(apiObject.VpcPeeringConnectionId),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisSecurityGroupRule(apiObject *ec2.AnalysisSecurityGroupRule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cidr":              aws.StringValue(apiObject.Cidr),
+		"direction":         aws.StringValue(apiObject.Direction),
+		"port_range":        flattenPortRange(apiObject.PortRange),
+		"prefix_list_id":    aws.StringValue(apiObject.PrefixListId),
+		"protocol":          aws.StringValue(apiObject.Protocol),
+		"security_group_id": aws.StringValue(apiObject.SecurityGroupId),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExplanations(apiObjects []*ec2.Explanation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"acl":                            flattenAnalysisComponent(apiObject.Acl),
+			"acl_rule":                       flattenAnalysisACLRule(apiObject.AclRule),
+			"address":                        aws.StringValue(apiObject.Address),
+			"addresses":                      aws.StringValueSlice(apiObject.Addresses),
+			"attached_to":                    flattenAnalysisComponent(apiObject.AttachedTo),
+			"availability_zones":             aws.StringValueSlice(apiObject.AvailabilityZones),
+			"cidrs":                          aws.StringValueSlice(apiObject.Cidrs),
+			"classic_load_balancer_listener": flattenAnalysisLoadBalancerListener(apiObject.ClassicLoadBalancerListener),
+			"component":                      flattenAnalysisComponent(apiObject.Component),
+			"customer_gateway":               flattenAnalysisComponent(apiObject.CustomerGateway),
+			"destination":                    flattenAnalysisComponent(apiObject.Destination),
+			"destination_vpc":                flattenAnalysisComponent(apiObject.DestinationVpc),
+			"direction":                      aws.StringValue(apiObject.Direction),
+			"elastic_load_balancer_listener": flattenAnalysisComponent(apiObject.ElasticLoadBalancerListener),
+			"explanation_code":               aws.StringValue(apiObject.ExplanationCode),
+			"ingress_route_table":            flattenAnalysisComponent(apiObject.IngressRouteTable),
+			"internet_gateway":               flattenAnalysisComponent(apiObject.InternetGateway),
+			"load_balancer_arn":              aws.StringValue(apiObject.LoadBalancerArn),
+			"load_balancer_listener_port":    aws.Int64Value(apiObject.LoadBalancerListenerPort),
+			"load_balancer_target":           flattenAnalysisLoadBalancerTarget(apiObject.LoadBalancerTarget),
+			"load_balancer_target_group":     flattenAnalysisComponent(apiObject.LoadBalancerTargetGroup),
+			"load_balancer_target_groups":    flattenAnalysisComponents(apiObject.LoadBalancerTargetGroups),
+			"load_balancer_target_port":      aws.Int64Value(apiObject.LoadBalancerTargetPort),
+			"missing_component":              aws.StringValue(apiObject.MissingComponent),
+			"nat_gateway":                    flattenAnalysisComponent(apiObject.NatGateway),
+			"network_interface":              flattenAnalysisComponent(apiObject.NetworkInterface),
+			"packet_field":                   aws.StringValue(apiObject.PacketField),
+			"port":                           aws.Int64Value(apiObject.Port),
+			"port_ranges":                    flattenPortRanges(apiObject.PortRanges),
+			"prefix_list":                    flattenAnalysisComponent(apiObject.PrefixList),
+			"protocols":                      aws.StringValueSlice(apiObject.Protocols),
+			"route_table":                    flattenAnalysisComponent(apiObject.RouteTable),
+			"route_table_route":              flattenAnalysisRouteTableRoute(apiObject.RouteTableRoute),
+			"security_group":                 flattenAnalysisComponent(apiObject.SecurityGroup),
+			"security_group_rule":            flattenAnalysisSecurityGroupRule(apiObject.SecurityGroupRule),
+			"security_groups":                flattenAnalysisComponents(apiObject.SecurityGroups),
+			"source_vpc":                     flattenAnalysisComponent(apiObject.SourceVpc),
+			"state":                          aws.StringValue(apiObject.State),
+			"subnet":                         flattenAnalysisComponent(apiObject.Subnet),
+			"subnet_route_table":             flattenAnalysisComponent(apiObject.SubnetRouteTable),
+			"vpc":                            flattenAnalysisComponent(apiObject.Vpc),
+			"vpc_endpoint":                   flattenAnalysisComponent(apiObject.VpcEndpoint),
+			"vpc_peering_connection":         flattenAnalysisComponent(apiObject.VpcPeeringConnection),
+			"vpn_connection":                 flattenAnalysisComponent(apiObject.VpnConnection),
+			"vpn_gateway":                    flattenAnalysisComponent(apiObject.VpnGateway),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPathComponents(apiObjects []*ec2.PathComponent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"acl_rule":            flattenAnalysisACLRule(apiObject.AclRule),
+			"component":           flattenAnalysisComponent(apiObject.Component),
+			"destination_vpc":     flattenAnalysisComponent(apiObject.DestinationVpc),
+			"inbound_header":      flattenAnalysisPacketHeader(apiObject.InboundHeader),
+			"outbound_header":     flattenAnalysisPacketHeader(apiObject.OutboundHeader),
+			"route_table_route":   flattenAnalysisRouteTableRoute(apiObject.RouteTableRoute),
+			"security_group_rule": flattenAnalysisSecurityGroupRule(apiObject.SecurityGroupRule),
+			"sequence_number":     aws.Int64Value(apiObject.SequenceNumber),
+			"source_vpc":          flattenAnalysisComponent(apiObject.SourceVpc),
+			"subnet":              flattenAnalysisComponent(apiObject.Subnet),
+			"vpc":                 flattenAnalysisComponent(apiObject.Vpc),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,411 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// IPAM pool locale value returned when no locale is set.
+	ipamPoolLocaleNone = "None"
+
+	// Allocations and CIDRs are released asynchronously, so a pool can briefly refuse deletion.
+	ipamPoolDeleteRetryTimeout = 5 * time.Minute
+)
+
+func ResourceVPCIPAMPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCIPAMPoolCreate,
+		Read:   resourceVPCIPAMPoolRead,
+		Update: resourceVPCIPAMPoolUpdate,
+		Delete: resourceVPCIPAMPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.AddressFamily_Values(), false),
+			},
+			"allocation_default_netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"allocation_max_netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"allocation_min_netmask_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+			"allocation_resource_tags": tftags.TagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_import": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"aws_service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.IpamPoolAwsService_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ipam_scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ipam_scope_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"pool_depth": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"publicly_advertisable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_ipam_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceVPCIPAMPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	addressFamily := d.Get("address_family").(string)
+	input := &ec2.CreateIpamPoolInput{
+		AddressFamily: aws.String(addressFamily),
+		IpamScopeId:   aws.String(d.Get("ipam_scope_id").(string)),
+	}
+
+	if v, ok := d.GetOk("allocation_default_netmask_length"); ok {
+		input.AllocationDefaultNetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("allocation_max_netmask_length"); ok {
+		input.AllocationMaxNetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("allocation_min_netmask_length"); ok {
+		input.AllocationMinNetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("allocation_resource_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AllocationResourceTags = ipamResourceTags(tftags.New(v.(map[string]interface{})))
+	}
+
+	if v, ok := d.GetOk("auto_import"); ok {
+		input.AutoImport = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("aws_service"); ok {
+		input.AwsService = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	// publicly_advertisable is only valid for IPv6 pools.
+	if addressFamily == ec2.AddressFamilyIpv6 {
+		input.PubliclyAdvertisable = aws.Bool(d.Get("publicly_advertisable").(bool))
+	}
+
+	if v, ok := d.GetOk("source_ipam_pool_id"); ok {
+		input.SourceIpamPoolId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.TagSpecifications = ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeIpamPool)
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC IPAM Pool: %s", input)
+	output, err := conn.CreateIpamPool(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC IPAM Pool: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.IpamPool.IpamPoolId))
+
+	if _, err := WaitIPAMPoolCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Pool (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceVPCIPAMPoolRead(d, meta)
+}
+
+func resourceVPCIPAMPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	pool, err := FindIPAMPoolByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM Pool (%s): %w", d.Id(), err)
+	}
+
+	scopeID, err := ipamScopeIDFromARN(aws.StringValue(pool.IpamScopeArn))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("address_family", pool.AddressFamily)
+	d.Set("allocation_default_netmask_length", pool.AllocationDefaultNetmaskLength)
+	d.Set("allocation_max_netmask_length", pool.AllocationMaxNetmaskLength)
+	d.Set("allocation_min_netmask_length", pool.AllocationMinNetmaskLength)
+
+	if err := d.Set("allocation_resource_tags", flattenIPAMResourceTags(pool.AllocationResourceTags)); err != nil {
+		return fmt.Errorf("error setting allocation_resource_tags: %w", err)
+	}
+
+	d.Set("arn", pool.IpamPoolArn)
+	d.Set("auto_import", pool.AutoImport)
+	d.Set("aws_service", pool.AwsService)
+	d.Set("description", pool.Description)
+	d.Set("ipam_scope_id", scopeID)
+	d.Set("ipam_scope_type", pool.IpamScopeType)
+
+	if v := aws.StringValue(pool.Locale); v != ipamPoolLocaleNone {
+		d.Set("locale", v)
+	} else {
+		d.Set("locale", nil)
+	}
+
+	d.Set("pool_depth", pool.PoolDepth)
+	d.Set("publicly_advertisable", pool.PubliclyAdvertisable)
+	d.Set("source_ipam_pool_id", pool.SourceIpamPoolId)
+	d.Set("state", pool.State)
+
+	tags := KeyValueTags(pool.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVPCIPAMPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifyIpamPoolInput{
+			IpamPoolId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("allocation_default_netmask_length") {
+			if v, ok := d.GetOk("allocation_default_netmask_length"); ok {
+				input.AllocationDefaultNetmaskLength = aws.Int64(int64(v.(int)))
+			} else {
+				input.ClearAllocationDefaultNetmaskLength = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("allocation_max_netmask_length") {
+			input.AllocationMaxNetmaskLength = aws.Int64(int64(d.Get("allocation_max_netmask_length").(int)))
+		}
+
+		if d.HasChange("allocation_min_netmask_length") {
+			input.AllocationMinNetmaskLength = aws.Int64(int64(d.Get("allocation_min_netmask_length").(int)))
+		}
+
+		if d.HasChange("allocation_resource_tags") {
+			o, n := d.GetChange("allocation_resource_tags")
+			oldTags, newTags := tftags.New(o), tftags.New(n)
+
+			if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+				input.RemoveAllocationResourceTags = ipamResourceTags(removedTags)
+			}
+
+			if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+				input.AddAllocationResourceTags = ipamResourceTags(updatedTags)
+			}
+		}
+
+		if d.HasChange("auto_import") {
+			input.AutoImport = aws.Bool(d.Get("auto_import").(bool))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		log.Printf("[DEBUG] Updating EC2 VPC IPAM Pool: %s", input)
+		if _, err := conn.ModifyIpamPool(input); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM Pool (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitIPAMPoolUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 VPC IPAM Pool (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM Pool (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceVPCIPAMPoolRead(d, meta)
+}
+
+func resourceVPCIPAMPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[DEBUG] Deleting EC2 VPC IPAM Pool: %s", d.Id())
+	_, err := tfresource.RetryWhen(ipamPoolDeleteRetryTimeout,
+		func() (interface{}, error) {
+			return conn.DeleteIpamPool(&ec2.DeleteIpamPoolInput{
+				IpamPoolId: aws.String(d.Id()),
+			})
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, ErrCodeIncorrectState, "has allocations") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidIPAMPoolIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC IPAM Pool (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitIPAMPoolDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Pool (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ipamScopeIDFromARN returns the IPAM scope ID from an IPAM scope ARN, e.g. arn:aws:ec2::123456789012:ipam-scope/ipam-scope-0123456789abcdef0.
+func ipamScopeIDFromARN(v string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing EC2 VPC IPAM Scope ARN (%s): %w", v, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 2 || parts[0] != "ipam-scope" || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format of EC2 VPC IPAM Scope ARN (%s), expected arn:PARTITION:ec2::ACCOUNT:ipam-scope/IPAM_SCOPE_ID", v)
+	}
+
+	return parts[1], nil
+}
+
+func ipamResourceTags(tags tftags.KeyValueTags) []*ec2.RequestIpamResourceTag {
+	var apiObjects []*ec2.RequestIpamResourceTag
+
+	for k, v := range tags.Map() {
+		apiObjects = append(apiObjects, &ec2.RequestIpamResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenIPAMResourceTags(apiObjects []*ec2.IpamResourceTag) map[string]string {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	m := make(map[string]string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		m[aws.StringValue(apiObject.Key)] = aws.StringValue(apiObject.Value)
+	}
+
+	return m
+}
@@ -0,0 +1,197 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCIPAMPoolCIDR() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCIPAMPoolCIDRCreate,
+		Read:   resourceVPCIPAMPoolCIDRRead,
+		Delete: resourceVPCIPAMPoolCIDRDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  verify.ValidCIDRNetworkAddress,
+				ConflictsWith: []string{"netmask_length"},
+			},
+			"cidr_authorization_context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"signature": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"ipam_pool_cidr_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(0, 128),
+				ConflictsWith: []string{"cidr"},
+			},
+		},
+	}
+}
+
+func resourceVPCIPAMPoolCIDRCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	poolID := d.Get("ipam_pool_id").(string)
+	input := &ec2.ProvisionIpamPoolCidrInput{
+		IpamPoolId: aws.String(poolID),
+	}
+
+	if v, ok := d.GetOk("cidr"); ok {
+		input.Cidr = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_authorization_context"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CidrAuthorizationContext = expandIPAMCIDRAuthorizationContext(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("netmask_length"); ok {
+		input.NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Provisioning EC2 VPC IPAM Pool CIDR: %s", input)
+	output, err := conn.ProvisionIpamPoolCidr(input)
+
+	if err != nil {
+		return fmt.Errorf("error provisioning EC2 VPC IPAM Pool (%s) CIDR: %w", poolID, err)
+	}
+
+	poolCIDRID := aws.StringValue(output.IpamPoolCidr.IpamPoolCidrId)
+	poolCIDR, err := WaitIPAMPoolCIDRProvisioned(conn, poolCIDRID, poolID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Pool (%s) CIDR (%s) provision: %w", poolID, poolCIDRID, err)
+	}
+
+	// When only a netmask length is supplied the CIDR is chosen by IPAM.
+	d.SetId(IPAMPoolCIDRCreateID(aws.StringValue(poolCIDR.Cidr), poolID))
+
+	return resourceVPCIPAMPoolCIDRRead(d, meta)
+}
+
+func resourceVPCIPAMPoolCIDRRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	cidrBlock, poolID, err := IPAMPoolCIDRParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindIPAMPoolCIDRByTwoPartKey(conn, cidrBlock, poolID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM Pool CIDR (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM Pool CIDR (%s): %w", d.Id(), err)
+	}
+
+	d.Set("cidr", output.Cidr)
+	d.Set("ipam_pool_cidr_id", output.IpamPoolCidrId)
+	d.Set("ipam_pool_id", poolID)
+	d.Set("netmask_length", output.NetmaskLength)
+
+	return nil
+}
+
+func resourceVPCIPAMPoolCIDRDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	cidrBlock, poolID, err := IPAMPoolCIDRParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deprovisioning EC2 VPC IPAM Pool CIDR: %s", d.Id())
+	_, err = conn.DeprovisionIpamPoolCidr(&ec2.DeprovisionIpamPoolCidrInput{
+		Cidr:       aws.String(cidrBlock),
+		IpamPoolId: aws.String(poolID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidIPAMPoolIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deprovisioning EC2 VPC IPAM Pool CIDR (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitIPAMPoolCIDRDeprovisioned(conn, cidrBlock, poolID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Pool CIDR (%s) deprovision: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIPAMCIDRAuthorizationContext(tfMap map[string]interface{}) *ec2.IpamCidrAuthorizationContext {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.IpamCidrAuthorizationContext{}
+
+	if v, ok := tfMap["message"].(string); ok && v != "" {
+		apiObject.Message = aws.String(v)
+	}
+
+	if v, ok := tfMap["signature"].(string); ok && v != "" {
+		apiObject.Signature = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,181 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccVPCIPAMPoolCIDR_basic(t *testing.T) {
+	var cidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.test"
+	cidrBlock := "10.0.0.0/16"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolCIDRDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolCIDRConfig_cidr(cidrBlock),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMPoolCIDRExists(resourceName, &cidr),
+					resource.TestCheckResourceAttr(resourceName, "cidr", cidrBlock),
+					resource.TestCheckResourceAttrSet(resourceName, "ipam_pool_cidr_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_pool_id", "aws_vpc_ipam_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "16"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVPCIPAMPoolCIDR_disappears(t *testing.T) {
+	var cidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolCIDRDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolCIDRConfig_cidr("10.0.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolCIDRExists(resourceName, &cidr),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCIPAMPoolCIDR(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccVPCIPAMPoolCIDR_netmaskLength(t *testing.T) {
+	var cidr ec2.IpamPoolCidr
+	resourceName := "aws_vpc_ipam_pool_cidr.child"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolCIDRDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolCIDRConfig_netmaskLength(24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMPoolCIDRExists(resourceName, &cidr),
+					resource.TestCheckResourceAttrSet(resourceName, "cidr"),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCIPAMPoolCIDRExists(n string, v *ec2.IpamPoolCidr) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Pool CIDR ID is set")
+		}
+
+		cidrBlock, poolID, err := tfec2.IPAMPoolCIDRParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindIPAMPoolCIDRByTwoPartKey(conn, cidrBlock, poolID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCIPAMPoolCIDRDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool_cidr" {
+			continue
+		}
+
+		cidrBlock, poolID, err := tfec2.IPAMPoolCIDRParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.FindIPAMPoolCIDRByTwoPartKey(conn, cidrBlock, poolID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Pool CIDR %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCIPAMPoolCIDRConfig_cidr(cidrBlock string) string {
+	return acctest.ConfigCompose(testAccVPCIPAMPoolConfig_basic(), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = %[1]q
+}
+`, cidrBlock))
+}
+
+func testAccVPCIPAMPoolCIDRConfig_netmaskLength(netmaskLength int) string {
+	return acctest.ConfigCompose(testAccVPCIPAMPoolCIDRConfig_cidr("10.0.0.0/16"), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool" "child" {
+  address_family      = "ipv4"
+  ipam_scope_id       = aws_vpc_ipam.test.private_default_scope_id
+  locale              = data.aws_region.current.name
+  source_ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+
+resource "aws_vpc_ipam_pool_cidr" "child" {
+  ipam_pool_id   = aws_vpc_ipam_pool.child.id
+  netmask_length = %[1]d
+}
+`, netmaskLength))
+}
@@ -0,0 +1,274 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccVPCIPAMPool_basic(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "address_family", "ipv4"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_default_netmask_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.%", "0"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ec2", regexp.MustCompile(`ipam-pool/ipam-pool-[[:xdigit:]]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_import", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_scope_id", "aws_vpc_ipam.test", "private_default_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "ipam_scope_type", "private"),
+					resource.TestCheckResourceAttr(resourceName, "locale", ""),
+					resource.TestCheckResourceAttr(resourceName, "pool_depth", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.IpamPoolStateCreateComplete),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCIPAMPoolConfig_updates(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "allocation_default_netmask_length", "32"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_max_netmask_length", "32"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_min_netmask_length", "24"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allocation_resource_tags.test", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_import", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCIPAMPool_disappears(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCIPAMPool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccVPCIPAMPool_ipv6PubliclyAdvertisable(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolConfig_ipv6PubliclyAdvertisable(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "address_family", "ipv6"),
+					resource.TestCheckResourceAttr(resourceName, "aws_service", "ec2"),
+					resource.TestCheckResourceAttr(resourceName, "ipam_scope_type", "public"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_advertisable", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVPCIPAMPool_tags(t *testing.T) {
+	var pool ec2.IpamPool
+	resourceName := "aws_vpc_ipam_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMPoolConfig_tags1("key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCIPAMPoolConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCIPAMPoolConfig_tags1("key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMPoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCIPAMPoolExists(n string, v *ec2.IpamPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Pool ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindIPAMPoolByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCIPAMPoolDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_pool" {
+			continue
+		}
+
+		_, err := tfec2.FindIPAMPoolByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Pool %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCIPAMPoolConfig_basic() string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig_description("test"), `
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+}
+`)
+}
+
+func testAccVPCIPAMPoolConfig_updates() string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig_description("test"), `
+resource "aws_vpc_ipam_pool" "test" {
+  address_family                    = "ipv4"
+  ipam_scope_id                     = aws_vpc_ipam.test.private_default_scope_id
+  auto_import                       = true
+  allocation_default_netmask_length = 32
+  allocation_max_netmask_length     = 32
+  allocation_min_netmask_length     = 24
+  description                       = "test"
+
+  allocation_resource_tags = {
+    test = "1"
+  }
+}
+`)
+}
+
+func testAccVPCIPAMPoolConfig_ipv6PubliclyAdvertisable(publiclyAdvertisable bool) string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig_description("test"), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool" "test" {
+  address_family        = "ipv6"
+  aws_service           = "ec2"
+  ipam_scope_id         = aws_vpc_ipam.test.public_default_scope_id
+  locale                = data.aws_region.current.name
+  publicly_advertisable = %[1]t
+}
+`, publiclyAdvertisable))
+}
+
+func testAccVPCIPAMPoolConfig_tags1(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig_description("test"), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccVPCIPAMPoolConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig_description("test"), fmt.Sprintf(`
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,223 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCIPAMScope() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCIPAMScopeCreate,
+		Read:   resourceVPCIPAMScopeRead,
+		Update: resourceVPCIPAMScopeUpdate,
+		Delete: resourceVPCIPAMScopeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ipam_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ipam_scope_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"pool_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceVPCIPAMScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateIpamScopeInput{
+		IpamId: aws.String(d.Get("ipam_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.TagSpecifications = ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeIpamScope)
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC IPAM Scope: %s", input)
+	output, err := conn.CreateIpamScope(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 VPC IPAM Scope: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.IpamScope.IpamScopeId))
+
+	if _, err := WaitIPAMScopeCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Scope (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceVPCIPAMScopeRead(d, meta)
+}
+
+func resourceVPCIPAMScopeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	scope, err := FindIPAMScopeByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC IPAM Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPC IPAM Scope (%s): %w", d.Id(), err)
+	}
+
+	ipamID, err := ipamIDFromARN(aws.StringValue(scope.IpamArn))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", scope.IpamScopeArn)
+	d.Set("description", scope.Description)
+	d.Set("ipam_arn", scope.IpamArn)
+	d.Set("ipam_id", ipamID)
+	d.Set("ipam_scope_type", scope.IpamScopeType)
+	d.Set("is_default", scope.IsDefault)
+	d.Set("pool_count", scope.PoolCount)
+
+	tags := KeyValueTags(scope.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVPCIPAMScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChange("description") {
+		input := &ec2.ModifyIpamScopeInput{
+			Description: aws.String(d.Get("description").(string)),
+			IpamScopeId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating EC2 VPC IPAM Scope: %s", d.Id())
+		if _, err := conn.ModifyIpamScope(input); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM Scope (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitIPAMScopeUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for EC2 VPC IPAM Scope (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 VPC IPAM Scope (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceVPCIPAMScopeRead(d, meta)
+}
+
+func resourceVPCIPAMScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[DEBUG] Deleting EC2 VPC IPAM Scope: %s", d.Id())
+	_, err := conn.DeleteIpamScope(&ec2.DeleteIpamScopeInput{
+		IpamScopeId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidIPAMScopeIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 VPC IPAM Scope (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitIPAMScopeDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 VPC IPAM Scope (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ipamIDFromARN returns the IPAM ID from an IPAM ARN, e.g. arn:aws:ec2::123456789012:ipam/ipam-0123456789abcdef0.
+func ipamIDFromARN(v string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing EC2 VPC IPAM ARN (%s): %w", v, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 2 || parts[0] != "ipam" || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format of EC2 VPC IPAM ARN (%s), expected arn:PARTITION:ec2::ACCOUNT:ipam/IPAM_ID", v)
+	}
+
+	return parts[1], nil
+}
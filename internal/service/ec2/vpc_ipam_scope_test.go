@@ -0,0 +1,136 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccVPCIPAMScope_basic(t *testing.T) {
+	var scope ec2.IpamScope
+	resourceName := "aws_vpc_ipam_scope.test"
+	ipamName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMScopeConfig_description("test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMScopeExists(resourceName, &scope),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ec2", regexp.MustCompile(`ipam-scope/ipam-scope-[[:xdigit:]]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_arn", ipamName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_id", ipamName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "ipam_scope_type", "private"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "pool_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCIPAMScopeConfig_description("test scope"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMScopeExists(resourceName, &scope),
+					resource.TestCheckResourceAttr(resourceName, "description", "test scope"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCIPAMScope_disappears(t *testing.T) {
+	var scope ec2.IpamScope
+	resourceName := "aws_vpc_ipam_scope.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMScopeConfig_description("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMScopeExists(resourceName, &scope),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCIPAMScope(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCIPAMScopeExists(n string, v *ec2.IpamScope) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM Scope ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindIPAMScopeByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCIPAMScopeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam_scope" {
+			continue
+		}
+
+		_, err := tfec2.FindIPAMScopeByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM Scope %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCIPAMScopeConfig_description(description string) string {
+	return acctest.ConfigCompose(testAccVPCIPAMConfig_description("test"), fmt.Sprintf(`
+resource "aws_vpc_ipam_scope" "test" {
+  ipam_id     = aws_vpc_ipam.test.id
+  description = %[1]q
+}
+`, description))
+}
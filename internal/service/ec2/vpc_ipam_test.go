@@ -0,0 +1,328 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The default quota is 1 IPAM per Region, so the IPAM tests run serially.
+func TestAccEC2VPCIPAM_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"IPAM": {
+			"basic":            testAccVPCIPAM_basic,
+			"disappears":       testAccVPCIPAM_disappears,
+			"operatingRegions": testAccVPCIPAM_operatingRegions,
+			"tags":             testAccVPCIPAM_tags,
+		},
+		"Pool": {
+			"basic":                    testAccVPCIPAMPool_basic,
+			"disappears":               testAccVPCIPAMPool_disappears,
+			"ipv6PubliclyAdvertisable": testAccVPCIPAMPool_ipv6PubliclyAdvertisable,
+			"tags":                     testAccVPCIPAMPool_tags,
+		},
+		"PoolCIDR": {
+			"basic":         testAccVPCIPAMPoolCIDR_basic,
+			"disappears":    testAccVPCIPAMPoolCIDR_disappears,
+			"netmaskLength": testAccVPCIPAMPoolCIDR_netmaskLength,
+		},
+		"PoolCIDRAllocation": {
+			"basic":         testAccVPCIPAMPoolCIDRAllocation_basic,
+			"disappears":    testAccVPCIPAMPoolCIDRAllocation_disappears,
+			"netmaskLength": testAccVPCIPAMPoolCIDRAllocation_netmaskLength,
+		},
+		"Scope": {
+			"basic":      testAccVPCIPAMScope_basic,
+			"disappears": testAccVPCIPAMScope_disappears,
+		},
+		"VPC": {
+			"ipamIPv4NetmaskLength": testAccVPC_ipamIPv4NetmaskLength,
+		},
+		"VPCIPv4CIDRBlockAssociation": {
+			"ipamIPv4NetmaskLength": testAccVPCIPv4CIDRBlockAssociation_ipamIPv4NetmaskLength,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccVPCIPAM_basic(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMConfig_description("test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ec2", regexp.MustCompile(`ipam/ipam-[[:xdigit:]]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operating_regions.*", map[string]string{
+						"region_name": acctest.Region(),
+					}),
+					resource.TestMatchResourceAttr(resourceName, "private_default_scope_id", regexp.MustCompile(`^ipam-scope-[[:xdigit:]]+$`)),
+					resource.TestMatchResourceAttr(resourceName, "public_default_scope_id", regexp.MustCompile(`^ipam-scope-[[:xdigit:]]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "scope_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCIPAMConfig_description("test ipam"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "description", "test ipam"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCIPAM_disappears(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMConfig_description("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCIPAM(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccVPCIPAM_operatingRegions(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMConfig_description("test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+				),
+			},
+			{
+				Config: testAccVPCIPAMConfig_twoOperatingRegions(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operating_regions.*", map[string]string{
+						"region_name": acctest.Region(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operating_regions.*", map[string]string{
+						"region_name": acctest.AlternateRegion(),
+					}),
+				),
+			},
+			{
+				Config: testAccVPCIPAMConfig_description("test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "operating_regions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCIPAM_tags(t *testing.T) {
+	var ipam ec2.Ipam
+	resourceName := "aws_vpc_ipam.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCIPAMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPAMConfig_tags1("key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCIPAMConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCIPAMConfig_tags1("key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCIPAMExists(resourceName, &ipam),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCIPAMExists(n string, v *ec2.Ipam) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC IPAM ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindIPAMByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCIPAMDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_ipam" {
+			continue
+		}
+
+		_, err := tfec2.FindIPAMByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC IPAM %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCIPAMConfig_description(description string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = %[1]q
+
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+`, description)
+}
+
+func testAccVPCIPAMConfig_twoOperatingRegions() string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  description = "test"
+
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  operating_regions {
+    region_name = %[1]q
+  }
+}
+`, acctest.AlternateRegion())
+}
+
+func testAccVPCIPAMConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccVPCIPAMConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -35,10 +35,29 @@ func ResourceVPCIPv4CIDRBlockAssociation() *schema.Resource {
 			},
 
 			"cidr_block": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsCIDRNetwork(16, 28), // The allowed block size is between a /28 netmask and /16 netmask.
+				ConflictsWith: []string{"ipv4_netmask_length"},
+				ExactlyOneOf:  []string{"cidr_block", "ipv4_ipam_pool_id"},
+			},
+
+			"ipv4_ipam_pool_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.IsCIDRNetwork(16, 28), // The allowed block size is between a /28 netmask and /16 netmask.
+				ExactlyOneOf: []string{"cidr_block", "ipv4_ipam_pool_id"},
+			},
+
+			"ipv4_netmask_length": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(16, 28),
+				ConflictsWith: []string{"cidr_block"},
+				RequiredWith:  []string{"ipv4_ipam_pool_id"},
 			},
 		},
 
@@ -50,12 +69,24 @@ func ResourceVPCIPv4CIDRBlockAssociation() *schema.Resource {
 }
 
 func resourceVPCIPv4CIDRBlockAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.AssociateVpcCidrBlockInput{
-		VpcId:     aws.String(d.Get("vpc_id").(string)),
-		CidrBlock: aws.String(d.Get("cidr_block").(string)),
+		VpcId: aws.String(d.Get("vpc_id").(string)),
+	}
+
+	if v, ok := d.GetOk("cidr_block"); ok {
+		req.CidrBlock = aws.String(v.(string))
 	}
+
+	if v, ok := d.GetOk("ipv4_ipam_pool_id"); ok {
+		req.Ipv4IpamPoolId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ipv4_netmask_length"); ok {
+		req.Ipv4NetmaskLength = aws.Int64(int64(v.(int)))
+	}
+
 	log.Printf("[DEBUG] Creating VPC IPv4 CIDR block association: %#v", req)
 	resp, err := conn.AssociateVpcCidrBlock(req)
 	if err != nil {
@@ -81,7 +112,7 @@ func resourceVPCIPv4CIDRBlockAssociationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceVPCIPv4CIDRBlockAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeVpcsInput{
 		Filters: BuildAttributeFilterList(
@@ -126,7 +157,7 @@ func resourceVPCIPv4CIDRBlockAssociationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceVPCIPv4CIDRBlockAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[DEBUG] Deleting VPC IPv4 CIDR block association: %s", d.Id())
 	_, err := conn.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{
@@ -41,7 +41,7 @@ func resourceVPCPeeringConnectionOptionsCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceVPCPeeringConnectionOptionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	pc, err := vpcPeeringConnection(conn, d.Id())
 
@@ -68,7 +68,7 @@ func resourceVPCPeeringConnectionOptionsRead(d *schema.ResourceData, meta interf
 }
 
 func resourceVPCPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	pc, err := vpcPeeringConnection(conn, d.Id())
 
@@ -0,0 +1,91 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCSecurityGroupEgressRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCSecurityGroupEgressRuleCreate,
+		Read:   resourceVPCSecurityGroupEgressRuleRead,
+		Update: resourceVPCSecurityGroupEgressRuleUpdate,
+		Delete: resourceVPCSecurityGroupEgressRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: vpcSecurityGroupRuleSchema(),
+	}
+}
+
+func resourceVPCSecurityGroupEgressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	input := &ec2.AuthorizeSecurityGroupEgressInput{
+		GroupId:           aws.String(securityGroupID),
+		IpPermissions:     []*ec2.IpPermission{expandVPCSecurityGroupRuleIPPermission(d)},
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule),
+	}
+
+	log.Printf("[DEBUG] Creating VPC Security Group Egress Rule: %s", input)
+	output, err := conn.AuthorizeSecurityGroupEgress(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating VPC Security Group (%s) Egress Rule: %w", securityGroupID, err)
+	}
+
+	if output == nil || len(output.SecurityGroupRules) != 1 {
+		return fmt.Errorf("error creating VPC Security Group (%s) Egress Rule: unexpected response", securityGroupID)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroupRules[0].SecurityGroupRuleId))
+
+	return resourceVPCSecurityGroupEgressRuleRead(d, meta)
+}
+
+func resourceVPCSecurityGroupEgressRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return resourceVPCSecurityGroupRuleRead(d, meta, true)
+}
+
+func resourceVPCSecurityGroupEgressRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceVPCSecurityGroupRuleUpdate(d, meta); err != nil {
+		return err
+	}
+
+	return resourceVPCSecurityGroupEgressRuleRead(d, meta)
+}
+
+func resourceVPCSecurityGroupEgressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[DEBUG] Deleting VPC Security Group Egress Rule: %s", d.Id())
+	_, err := conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+		GroupId:              aws.String(d.Get("security_group_id").(string)),
+		SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidSecurityGroupRuleIdNotFound, InvalidGroupNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting VPC Security Group Egress Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -34,7 +34,7 @@ func ResourceVPNGatewayAttachment() *schema.Resource {
 }
 
 func resourceVPNGatewayAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	vpcId := d.Get("vpc_id").(string)
 	vgwId := d.Get("vpn_gateway_id").(string)
@@ -63,7 +63,7 @@ func resourceVPNGatewayAttachmentCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceVPNGatewayAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	vpcId := d.Get("vpc_id").(string)
 	vgwId := d.Get("vpn_gateway_id").(string)
@@ -90,7 +90,7 @@ func resourceVPNGatewayAttachmentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVPNGatewayAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	vpcId := d.Get("vpc_id").(string)
 	vgwId := d.Get("vpn_gateway_id").(string)
@@ -39,7 +39,7 @@ func ResourceRegistryPolicy() *schema.Resource {
 }
 
 func resourceRegistryPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	input := ecr.PutRegistryPolicyInput{
 		PolicyText: aws.String(d.Get("policy").(string)),
@@ -58,7 +58,7 @@ func resourceRegistryPolicyPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRegistryPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	log.Printf("[DEBUG] Reading registry policy %s", d.Id())
 	out, err := conn.GetRegistryPolicy(&ecr.GetRegistryPolicyInput{})
@@ -78,7 +78,7 @@ func resourceRegistryPolicyRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRegistryPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	_, err := conn.DeleteRegistryPolicy(&ecr.DeleteRegistryPolicyInput{})
 	if err != nil {
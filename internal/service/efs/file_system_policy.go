@@ -46,7 +46,7 @@ func ResourceFileSystemPolicy() *schema.Resource {
 }
 
 func resourceFileSystemPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EFSConn
+	conn := meta.(*conns.AWSClient).EFSConn()
 
 	fsID := d.Get("file_system_id").(string)
 	input := &efs.PutFileSystemPolicyInput{
@@ -68,7 +68,7 @@ func resourceFileSystemPolicyPut(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceFileSystemPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EFSConn
+	conn := meta.(*conns.AWSClient).EFSConn()
 
 	output, err := FindFileSystemPolicyByID(conn, d.Id())
 
@@ -89,7 +89,7 @@ func resourceFileSystemPolicyRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceFileSystemPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EFSConn
+	conn := meta.(*conns.AWSClient).EFSConn()
 
 	log.Printf("[DEBUG] Deleting EFS File System Policy: %s", d.Id())
 	_, err := conn.DeleteFileSystemPolicy(&efs.DeleteFileSystemPolicyInput{
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
 // Firewall Management Service admin APIs are only enabled in specific regions, otherwise:
@@ -40,7 +39,7 @@ func testAccPreCheckFmsAdmin(t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderFmsAdminConfigure.Do(func() {
-		testAccProviderFmsAdmin = provider.Provider()
+		testAccProviderFmsAdmin = acctest.NewProvider()
 
 		config := map[string]interface{}{
 			"region": testAccGetFmsAdminRegion(),
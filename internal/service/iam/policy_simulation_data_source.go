@@ -0,0 +1,346 @@
+package iam
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"policies_json": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"policies_json", "policy_source_arn"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"policies_json", "policy_source_arn"},
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_handling_option": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	var results []*iam.EvaluationResult
+	var id string
+
+	// With a policy source ARN the principal's policies are simulated, otherwise only the supplied policies are.
+	if v, ok := d.GetOk("policy_source_arn"); ok {
+		input := &iam.SimulatePrincipalPolicyInput{
+			ActionNames:     flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+			PolicySourceArn: aws.String(v.(string)),
+		}
+
+		if v, ok := d.GetOk("caller_arn"); ok {
+			input.CallerArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+			input.ContextEntries = expandContextEntries(v.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && len(v.([]interface{})) > 0 {
+			input.PermissionsBoundaryPolicyInputList = flex.ExpandStringList(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("policies_json"); ok && len(v.([]interface{})) > 0 {
+			input.PolicyInputList = flex.ExpandStringList(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+			input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_handling_option"); ok {
+			input.ResourceHandlingOption = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_owner_account_id"); ok {
+			input.ResourceOwner = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_policy_json"); ok {
+			input.ResourcePolicy = aws.String(v.(string))
+		}
+
+		err := conn.SimulatePrincipalPolicyPages(input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			results = append(results, page.EvaluationResults...)
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error simulating IAM principal (%s) policy: %w", v.(string), err)
+		}
+
+		id = input.String()
+	} else {
+		input := &iam.SimulateCustomPolicyInput{
+			ActionNames:     flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+			PolicyInputList: flex.ExpandStringList(d.Get("policies_json").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("caller_arn"); ok {
+			input.CallerArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+			input.ContextEntries = expandContextEntries(v.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && len(v.([]interface{})) > 0 {
+			input.PermissionsBoundaryPolicyInputList = flex.ExpandStringList(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+			input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("resource_handling_option"); ok {
+			input.ResourceHandlingOption = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_owner_account_id"); ok {
+			input.ResourceOwner = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resource_policy_json"); ok {
+			input.ResourcePolicy = aws.String(v.(string))
+		}
+
+		err := conn.SimulateCustomPolicyPages(input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			results = append(results, page.EvaluationResults...)
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error simulating IAM custom policy: %w", err)
+		}
+
+		id = input.String()
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(id)))
+
+	allAllowed := true
+
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+
+		if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+			allAllowed = false
+		}
+	}
+
+	d.Set("all_allowed", allAllowed)
+
+	if err := d.Set("results", flattenEvaluationResults(results)); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	return nil
+}
+
+func expandContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iam.ContextEntry{
+			ContextKeyName:   aws.String(tfMap["key"].(string)),
+			ContextKeyType:   aws.String(tfMap["type"].(string)),
+			ContextKeyValues: flex.ExpandStringList(tfMap["values"].([]interface{})),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenEvaluationResults(apiObjects []*iam.EvaluationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action_name":            aws.StringValue(apiObject.EvalActionName),
+			"allowed":                aws.StringValue(apiObject.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed,
+			"decision":               aws.StringValue(apiObject.EvalDecision),
+			"decision_details":       aws.StringValueMap(apiObject.EvalDecisionDetails),
+			"matched_statements":     flattenStatements(apiObject.MatchedStatements),
+			"missing_context_values": aws.StringValueSlice(apiObject.MissingContextValues),
+			"resource_arn":           aws.StringValue(apiObject.EvalResourceName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenStatements(apiObjects []*iam.Statement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"source_policy_id":   aws.StringValue(apiObject.SourcePolicyId),
+			"source_policy_type": aws.StringValue(apiObject.SourcePolicyType),
+		})
+	}
+
+	return tfList
+}
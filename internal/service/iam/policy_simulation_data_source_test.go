@@ -0,0 +1,228 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicySimulationDataSource_customPolicy(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceCustomPolicyConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.*", map[string]string{
+						"action_name":          "s3:GetObject",
+						"allowed":              "true",
+						"decision":             iam.PolicyEvaluationDecisionTypeAllowed,
+						"matched_statements.#": "1",
+						"resource_arn":         "arn:aws:s3:::example/object", //lintignore:AWSAT005
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.*", map[string]string{
+						"action_name":          "s3:PutObject",
+						"allowed":              "false",
+						"decision":             iam.PolicyEvaluationDecisionTypeImplicitDeny,
+						"matched_statements.#": "0",
+						"resource_arn":         "arn:aws:s3:::example/object", //lintignore:AWSAT005
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicySimulationDataSource_context(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceContextConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.missing_context_values.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.missing_context_values.0", "aws:SourceIp"),
+				),
+			},
+			{
+				Config: testAccPolicySimulationDataSourceContextConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.missing_context_values.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicySimulationDataSource_principal(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_simulation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourcePrincipalConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "sqs:SendMessage"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", iam.PolicyEvaluationDecisionTypeAllowed),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", iam.PolicySourceTypeRole),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicySimulationDataSource_permissionsBoundary(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourcePermissionsBoundaryConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", iam.PolicyEvaluationDecisionTypeImplicitDeny),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicySimulationDataSourceCustomPolicyConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example/*"]
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_simulation" "test" {
+  action_names  = ["s3:GetObject", "s3:PutObject"]
+  policies_json = [data.aws_iam_policy_document.test.json]
+  resource_arns = ["arn:${data.aws_partition.current.partition}:s3:::example/object"]
+}
+`
+
+func testAccPolicySimulationDataSourceContextConfig(withContext bool) string {
+	context := ""
+
+	if withContext {
+		context = `
+  context {
+    key    = "aws:SourceIp"
+    type   = "ip"
+    values = ["10.0.0.1"]
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+
+    condition {
+      test     = "IpAddress"
+      variable = "aws:SourceIp"
+      values   = ["10.0.0.0/8"]
+    }
+  }
+}
+
+data "aws_iam_policy_simulation" "test" {
+  action_names  = ["s3:GetObject"]
+  policies_json = [data.aws_iam_policy_document.test.json]
+%[1]s
+}
+`, context)
+}
+
+func testAccPolicySimulationDataSourcePrincipalConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "assume" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ec2.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume.json
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "sqs:SendMessage"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+data "aws_iam_policy_simulation" "test" {
+  action_names      = ["sqs:SendMessage"]
+  policy_source_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
+
+const testAccPolicySimulationDataSourcePermissionsBoundaryConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject", "sqs:SendMessage"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "boundary" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_simulation" "test" {
+  action_names                       = ["sqs:SendMessage"]
+  policies_json                      = [data.aws_iam_policy_document.test.json]
+  permissions_boundary_policies_json = [data.aws_iam_policy_document.boundary.json]
+}
+`
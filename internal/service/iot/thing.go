@@ -57,7 +57,7 @@ func ResourceThing() *schema.Resource {
 }
 
 func resourceThingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	params := &iot.CreateThingInput{
 		ThingName: aws.String(d.Get("name").(string)),
@@ -84,7 +84,7 @@ func resourceThingCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceThingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	params := &iot.DescribeThingInput{
 		ThingName: aws.String(d.Id()),
@@ -113,7 +113,7 @@ func resourceThingRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceThingUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	params := &iot.UpdateThingInput{
 		ThingName: aws.String(d.Get("name").(string)),
@@ -147,7 +147,7 @@ func resourceThingUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceThingDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	params := &iot.DeleteThingInput{
 		ThingName: aws.String(d.Id()),
@@ -0,0 +1,161 @@
+package lambda
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ResourceInvocation invokes a Lambda function once per change rather than on every refresh.
+// The function is invoked on create, when the input or triggers change and, optionally, on destroy.
+func ResourceInvocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInvocationCreate,
+		Read:   schema.Noop,
+		Update: resourceInvocationUpdate,
+		Delete: resourceInvocationDelete,
+
+		CustomizeDiff: resourceInvocationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"destroy_input": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"input": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  FunctionVersionLatest,
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"update_input": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+	}
+}
+
+func resourceInvocationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	input := []byte(d.Get("input").(string))
+
+	log.Printf("[DEBUG] Creating Lambda Invocation (%s:%s)", functionName, qualifier)
+	result, err := invoke(conn, functionName, qualifier, input)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s_%x", functionName, qualifier, md5.Sum(input)))
+	d.Set("result", string(result))
+
+	return nil
+}
+
+func resourceInvocationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	// A change to destroy_input alone is recorded without invoking the function.
+	if !d.HasChanges("input", "triggers", "update_input") {
+		return nil
+	}
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	input := []byte(d.Get("input").(string))
+
+	if v, ok := d.GetOk("update_input"); ok {
+		input = []byte(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Lambda Invocation (%s)", d.Id())
+	result, err := invoke(conn, functionName, qualifier, input)
+
+	if err != nil {
+		// Keep the prior state so that the invocation is retried on the next apply.
+		d.Partial(true)
+
+		return err
+	}
+
+	d.Set("result", string(result))
+
+	return nil
+}
+
+func resourceInvocationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	v, ok := d.GetOk("destroy_input")
+
+	if !ok {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting Lambda Invocation (%s)", d.Id())
+	_, err := invoke(conn, d.Get("function_name").(string), d.Get("qualifier").(string), []byte(v.(string)))
+
+	return err
+}
+
+func resourceInvocationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The function is invoked again on update, so the result is not known until apply.
+	if d.HasChange("input") || d.HasChange("triggers") || d.HasChange("update_input") {
+		return d.SetNewComputed("result")
+	}
+
+	return nil
+}
+
+// invoke synchronously invokes the specified Lambda function and returns its response payload.
+// A function error is returned as an error containing the payload.
+func invoke(conn *lambda.Lambda, functionName, qualifier string, payload []byte) ([]byte, error) {
+	res, err := conn.Invoke(&lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+		Payload:        payload,
+		Qualifier:      aws.String(qualifier),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.FunctionError != nil {
+		return nil, fmt.Errorf("Lambda function (%s) returned error: (%s)", functionName, string(res.Payload))
+	}
+
+	return res.Payload, nil
+}
@@ -4,8 +4,6 @@ import (
 	"crypto/md5"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -42,28 +40,19 @@ func DataSourceInvocation() *schema.Resource {
 }
 
 func dataSourceInvocationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	functionName := d.Get("function_name").(string)
 	qualifier := d.Get("qualifier").(string)
 	input := []byte(d.Get("input").(string))
 
-	res, err := conn.Invoke(&lambda.InvokeInput{
-		FunctionName:   aws.String(functionName),
-		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
-		Payload:        input,
-		Qualifier:      aws.String(qualifier),
-	})
+	result, err := invoke(conn, functionName, qualifier, input)
 
 	if err != nil {
 		return err
 	}
 
-	if res.FunctionError != nil {
-		return fmt.Errorf("Lambda function (%s) returned error: (%s)", functionName, string(res.Payload))
-	}
-
-	if err = d.Set("result", string(res.Payload)); err != nil {
+	if err = d.Set("result", string(result)); err != nil {
 		return err
 	}
 
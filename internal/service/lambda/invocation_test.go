@@ -0,0 +1,184 @@
+package lambda_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLambdaInvocation_basic(t *testing.T) {
+	resourceName := "aws_lambda_invocation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testData := "value3"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvocationConfig(rName, testData, `{"key1":"value1","key2":"value2"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaInvocationResult(resourceName, `{"key1":"value1","key2":"value2","key3":"`+testData+`"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaInvocation_qualifier(t *testing.T) {
+	resourceName := "aws_lambda_invocation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testData := "value3"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvocationQualifierConfig(rName, testData),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaInvocationResult(resourceName, `{"key1":"value1","key2":"value2","key3":"`+testData+`"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaInvocation_triggers(t *testing.T) {
+	resourceName := "aws_lambda_invocation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testData := "value3"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvocationTriggersConfig(rName, testData, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaInvocationResult(resourceName, `{"phase":"create","key3":"`+testData+`"}`),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.revision", "1"),
+				),
+			},
+			{
+				// Re-applying the same configuration must not invoke the function again.
+				Config:   testAccInvocationTriggersConfig(rName, testData, "1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccInvocationTriggersConfig(rName, testData, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaInvocationResult(resourceName, `{"phase":"update","key3":"`+testData+`"}`),
+					resource.TestCheckResourceAttr(resourceName, "triggers.revision", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaInvocation_functionError(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				// The handler does not exist, so the function fails.
+				Config:      testAccInvocationFunctionErrorConfig(rName),
+				ExpectError: regexp.MustCompile(`returned error`),
+			},
+		},
+	})
+}
+
+func testAccInvocationFunctionConfig(rName, testData string, publish bool) string {
+	return acctest.ConfigCompose(testAccInvocationDataSource_base_config(rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.lambda_role_policy]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda_role.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs12.x"
+  publish       = %[3]t
+
+  environment {
+    variables = {
+      TEST_DATA = %[2]q
+    }
+  }
+}
+`, rName, testData, publish))
+}
+
+func testAccInvocationConfig(rName, testData, input string) string {
+	return acctest.ConfigCompose(testAccInvocationFunctionConfig(rName, testData, false), fmt.Sprintf(`
+resource "aws_lambda_invocation" "test" {
+  function_name = aws_lambda_function.test.function_name
+
+  input = %[1]q
+}
+`, input))
+}
+
+func testAccInvocationQualifierConfig(rName, testData string) string {
+	return acctest.ConfigCompose(testAccInvocationFunctionConfig(rName, testData, true), `
+resource "aws_lambda_invocation" "test" {
+  function_name = aws_lambda_function.test.function_name
+  qualifier     = aws_lambda_function.test.version
+
+  input = jsonencode({
+    key1 = "value1"
+    key2 = "value2"
+  })
+}
+`)
+}
+
+func testAccInvocationTriggersConfig(rName, testData, revision string) string {
+	return acctest.ConfigCompose(testAccInvocationFunctionConfig(rName, testData, false), fmt.Sprintf(`
+resource "aws_lambda_invocation" "test" {
+  function_name = aws_lambda_function.test.function_name
+
+  input         = jsonencode({ phase = "create" })
+  update_input  = jsonencode({ phase = "update" })
+  destroy_input = jsonencode({ phase = "destroy" })
+
+  triggers = {
+    revision = %[1]q
+  }
+}
+`, revision))
+}
+
+func testAccInvocationFunctionErrorConfig(rName string) string {
+	return acctest.ConfigCompose(testAccInvocationDataSource_base_config(rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.lambda_role_policy]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda_role.arn
+  handler       = "lambda_invocation.missing"
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_lambda_invocation" "test" {
+  function_name = aws_lambda_function.test.function_name
+
+  input = jsonencode({})
+}
+`, rName))
+}
@@ -39,7 +39,7 @@ func ResourceAssociation() *schema.Resource {
 }
 
 func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 
 	resourceArn := d.Get("resource_arn").(string)
 	licenseConfigurationArn := d.Get("license_configuration_arn").(string)
@@ -64,7 +64,7 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 
 	resourceArn, licenseConfigurationArn, err := AssociationParseID(d.Id())
 	if err != nil {
@@ -123,7 +123,7 @@ func AssociationParseID(id string) (string, string, error) {
 }
 
 func resourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 
 	resourceArn, licenseConfigurationArn, err := AssociationParseID(d.Id())
 	if err != nil {
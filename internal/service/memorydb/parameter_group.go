@@ -0,0 +1,431 @@
+package memorydb
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceParameterGroupCreate,
+		Read:   resourceParameterGroupRead,
+		Update: resourceParameterGroupUpdate,
+		Delete: resourceParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Managed by Terraform",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateResourceName(parameterGroupNameMaxLength),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateResourceNamePrefix(parameterGroupNameMaxLength - resource.UniqueIDSuffixLength),
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: ParameterHash,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateParameterGroupInput{
+		Description:        aws.String(d.Get("description").(string)),
+		Family:             aws.String(d.Get("family").(string)),
+		ParameterGroupName: aws.String(name),
+		Tags:               Tags(tags.IgnoreAWS()),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Parameter Group: %s", input)
+	output, err := conn.CreateParameterGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB Parameter Group (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("arn", output.ParameterGroup.ARN)
+
+	// Update to apply parameter changes.
+	return resourceParameterGroupUpdate(d, meta)
+}
+
+func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	group, err := FindParameterGroupByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("family", group.Family)
+	d.Set("name", group.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(group.Name)))
+
+	userDefinedParameters := createUserDefinedParameterMap(d)
+
+	parameters, err := listParameterGroupParameters(conn, d.Get("family").(string), d.Id(), userDefinedParameters)
+
+	if err != nil {
+		return fmt.Errorf("error listing parameters for MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("parameter", flattenParameters(parameters)); err != nil {
+		return fmt.Errorf("error setting parameter: %w", err)
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn()
+
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		toRemove, toAdd := ParameterChanges(o, n)
+
+		log.Printf("[DEBUG] Parameters to remove: %#v", toRemove)
+		log.Printf("[DEBUG] Parameters to add or update: %#v", toAdd)
+
+		// The API is limited to updating no more than 20 parameters at once.
+		const maxParams = 20
+
+		for len(toRemove) > 0 {
+			// Removing a parameter from state is equivalent to resetting it
+			// to its default state.
+
+			var paramsToReset []*memorydb.ParameterNameValue
+			if len(toRemove) <= maxParams {
+				paramsToReset, toRemove = toRemove[:], nil
+			} else {
+				paramsToReset, toRemove = toRemove[:maxParams], toRemove[maxParams:]
+			}
+
+			err := resetParameterGroupParameters(conn, d.Get("name").(string), paramsToReset)
+
+			if err != nil {
+				return fmt.Errorf("error resetting MemoryDB Parameter Group (%s) parameters to defaults: %w", d.Id(), err)
+			}
+		}
+
+		for len(toAdd) > 0 {
+			var paramsToModify []*memorydb.ParameterNameValue
+			if len(toAdd) <= maxParams {
+				paramsToModify, toAdd = toAdd[:], nil
+			} else {
+				paramsToModify, toAdd = toAdd[:maxParams], toAdd[maxParams:]
+			}
+
+			err := modifyParameterGroupParameters(conn, d.Get("name").(string), paramsToModify)
+
+			if err != nil {
+				return fmt.Errorf("error modifying MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB Parameter Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceParameterGroupRead(d, meta)
+}
+
+func resourceParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MemoryDBConn()
+
+	log.Printf("[DEBUG] Deleting MemoryDB Parameter Group: (%s)", d.Id())
+	_, err := conn.DeleteParameterGroup(&memorydb.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resetParameterGroupParameters resets the given parameters to their default values.
+func resetParameterGroupParameters(conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	var parameterNames []*string
+	for _, parameter := range parameters {
+		parameterNames = append(parameterNames, parameter.ParameterName)
+	}
+
+	input := memorydb.ResetParameterGroupInput{
+		ParameterGroupName: aws.String(name),
+		ParameterNames:     parameterNames,
+	}
+
+	return resource.Retry(parameterGroupResetTimeout, func() *resource.RetryError {
+		_, err := conn.ResetParameterGroup(&input)
+
+		if tfawserr.ErrMessageContains(err, memorydb.ErrCodeInvalidParameterGroupStateFault, " has pending changes") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+// modifyParameterGroupParameters updates the given parameters.
+func modifyParameterGroupParameters(conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	input := memorydb.UpdateParameterGroupInput{
+		ParameterGroupName:  aws.String(name),
+		ParameterNameValues: parameters,
+	}
+
+	_, err := conn.UpdateParameterGroup(&input)
+
+	return err
+}
+
+// listParameterGroupParameters returns the user-defined MemoryDB parameters
+// in the group with the given name and family.
+//
+// Parameters given in userDefined will be returned even if the value is equal
+// to the default.
+func listParameterGroupParameters(conn *memorydb.MemoryDB, family, name string, userDefined map[string]string) ([]*memorydb.Parameter, error) {
+	// There isn't an official API for defaults, and the mapping of family
+	// to default parameter group name is a guess.
+	defaultsFamily := "default." + strings.ReplaceAll(family, "_", "-")
+
+	defaults, err := FindParametersByParameterGroupName(conn, defaultsFamily)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading default parameter group (%s): %w", defaultsFamily, err)
+	}
+
+	defaultValueByName := map[string]string{}
+	for _, defaultPV := range defaults {
+		defaultValueByName[aws.StringValue(defaultPV.Name)] = aws.StringValue(defaultPV.Value)
+	}
+
+	current, err := FindParametersByParameterGroupName(conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*memorydb.Parameter
+
+	for _, parameter := range current {
+		name := aws.StringValue(parameter.Name)
+		currentValue := aws.StringValue(parameter.Value)
+		defaultValue := defaultValueByName[name]
+		_, isUserDefined := userDefined[name]
+
+		if currentValue != defaultValue || isUserDefined {
+			result = append(result, parameter)
+		}
+	}
+
+	return result, nil
+}
+
+// createUserDefinedParameterMap returns a map of all the parameter names and
+// values configured by the user.
+func createUserDefinedParameterMap(d *schema.ResourceData) map[string]string {
+	result := map[string]string{}
+
+	for _, param := range d.Get("parameter").(*schema.Set).List() {
+		m, ok := param.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name, ok := m["name"].(string)
+
+		if !ok || name == "" {
+			continue
+		}
+
+		value, ok := m["value"].(string)
+
+		if !ok {
+			continue
+		}
+
+		result[name] = value
+	}
+
+	return result
+}
+
+func ParameterHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["value"].(string)))
+
+	return create.StringHashcode(buf.String())
+}
+
+func ParameterChanges(o, n interface{}) (remove, addOrUpdate []*memorydb.ParameterNameValue) {
+	if o == nil {
+		o = new(schema.Set)
+	}
+	if n == nil {
+		n = new(schema.Set)
+	}
+
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	om := make(map[string]*memorydb.ParameterNameValue, os.Len())
+	for _, raw := range os.List() {
+		param := raw.(map[string]interface{})
+		om[param["name"].(string)] = expandParameterNameValue(param)
+	}
+	nm := make(map[string]*memorydb.ParameterNameValue, len(addOrUpdate))
+	for _, raw := range ns.List() {
+		param := raw.(map[string]interface{})
+		nm[param["name"].(string)] = expandParameterNameValue(param)
+	}
+
+	// Remove: key is in old, but not in new
+	remove = make([]*memorydb.ParameterNameValue, 0, os.Len())
+	for k := range om {
+		if _, ok := nm[k]; !ok {
+			remove = append(remove, om[k])
+		}
+	}
+
+	// Add or Update: key is in new, but not in old or has changed value
+	addOrUpdate = make([]*memorydb.ParameterNameValue, 0, ns.Len())
+	for k, nv := range nm {
+		ov, ok := om[k]
+		if !ok || ok && (aws.StringValue(nv.ParameterValue) != aws.StringValue(ov.ParameterValue)) {
+			addOrUpdate = append(addOrUpdate, nm[k])
+		}
+	}
+
+	return remove, addOrUpdate
+}
+
+func flattenParameters(list []*memorydb.Parameter) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+	for _, i := range list {
+		if i.Value != nil {
+			result = append(result, map[string]interface{}{
+				"name":  strings.ToLower(aws.StringValue(i.Name)),
+				"value": aws.StringValue(i.Value),
+			})
+		}
+	}
+	return result
+}
+
+func expandParameterNameValue(param map[string]interface{}) *memorydb.ParameterNameValue {
+	return &memorydb.ParameterNameValue{
+		ParameterName:  aws.String(param["name"].(string)),
+		ParameterValue: aws.String(param["value"].(string)),
+	}
+}
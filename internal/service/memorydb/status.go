@@ -0,0 +1,153 @@
+package memorydb
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Status values are not currently provided as constants by the AWS Go SDK.
+const (
+	ACLStatusActive    = "active"
+	ACLStatusCreating  = "creating"
+	ACLStatusDeleting  = "deleting"
+	ACLStatusModifying = "modifying"
+
+	ClusterStatusAvailable    = "available"
+	ClusterStatusCreating     = "creating"
+	ClusterStatusDeleting     = "deleting"
+	ClusterStatusSnapshotting = "snapshotting"
+	ClusterStatusUpdating     = "updating"
+
+	ClusterParameterGroupStatusApplying = "applying"
+	ClusterParameterGroupStatusInSync   = "in-sync"
+
+	ClusterShardStatusAvailable = "available"
+
+	ClusterSNSTopicStatusActive   = "ACTIVE"
+	ClusterSNSTopicStatusInactive = "INACTIVE"
+
+	ClusterSecurityGroupStatusActive    = "active"
+	ClusterSecurityGroupStatusModifying = "modifying"
+
+	SnapshotStatusAvailable = "available"
+	SnapshotStatusCreating  = "creating"
+	SnapshotStatusDeleting  = "deleting"
+
+	UserStatusActive    = "active"
+	UserStatusDeleting  = "deleting"
+	UserStatusModifying = "modifying"
+)
+
+// statusACL fetches the MemoryDB ACL and its status.
+func statusACL(conn *memorydb.MemoryDB, aclName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		acl, err := FindACLByName(conn, aclName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return acl, aws.StringValue(acl.Status), nil
+	}
+}
+
+// statusCluster fetches the MemoryDB Cluster and its status.
+func statusCluster(conn *memorydb.MemoryDB, clusterName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := FindClusterByName(conn, clusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.StringValue(cluster.Status), nil
+	}
+}
+
+// statusClusterParameterGroup fetches the MemoryDB Cluster and its parameter group status.
+func statusClusterParameterGroup(conn *memorydb.MemoryDB, clusterName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := FindClusterByName(conn, clusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster.ParameterGroupStatus, aws.StringValue(cluster.ParameterGroupStatus), nil
+	}
+}
+
+// statusClusterSecurityGroups fetches the MemoryDB Cluster and returns a
+// status of "modifying" until all of its security groups are active.
+func statusClusterSecurityGroups(conn *memorydb.MemoryDB, clusterName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := FindClusterByName(conn, clusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, sg := range cluster.SecurityGroups {
+			// When at least one security group change is being applied (or
+			// any other state that is not active), consider the resource to
+			// be modifying.
+			if status := aws.StringValue(sg.Status); status != ClusterSecurityGroupStatusActive {
+				return cluster.SecurityGroups, ClusterSecurityGroupStatusModifying, nil
+			}
+		}
+
+		return cluster.SecurityGroups, ClusterSecurityGroupStatusActive, nil
+	}
+}
+
+// statusSnapshot fetches the MemoryDB Snapshot and its status.
+func statusSnapshot(conn *memorydb.MemoryDB, snapshotName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := FindSnapshotByName(conn, snapshotName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return snapshot, aws.StringValue(snapshot.Status), nil
+	}
+}
+
+// statusUser fetches the MemoryDB User and its status.
+func statusUser(conn *memorydb.MemoryDB, userName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		user, err := FindUserByName(conn, userName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return user, aws.StringValue(user.Status), nil
+	}
+}
@@ -0,0 +1,48 @@
+package memorydb
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	aclNameMaxLength            = 40
+	clusterNameMaxLength        = 40
+	parameterGroupNameMaxLength = 255
+	snapshotNameMaxLength       = 255
+	subnetGroupNameMaxLength    = 255
+	userNameMaxLength           = 40
+)
+
+// validateResourceName returns a validation function for the names of
+// MemoryDB resources. Names must begin with a letter, contain only lowercase
+// letters, digits and hyphens, and must not end with a hyphen or contain
+// two consecutive hyphens.
+func validateResourceName(maxLength int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLength),
+		validateResourceNamePrefix(maxLength),
+		validation.StringDoesNotMatch(
+			regexp.MustCompile(`-$`),
+			"The name may not end with a hyphen."),
+	)
+}
+
+// validateResourceNamePrefix returns a validation function for the name
+// prefixes of MemoryDB resources.
+func validateResourceNamePrefix(maxLength int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLength),
+		validation.StringDoesNotMatch(
+			regexp.MustCompile(`[-][-]`),
+			"The name may not contain two consecutive hyphens."),
+		validation.StringMatch(
+			regexp.MustCompile(`^[a-z]`),
+			"The name must begin with a lower case letter."),
+		validation.StringMatch(
+			regexp.MustCompile(`^[-0-9a-z]+$`),
+			"Only lowercase alphanumeric characters and hyphens allowed."),
+	)
+}
@@ -0,0 +1,74 @@
+package memorydb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateResourceName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"a1",
+		"a-b",
+		"tf-acc-test-1234567890",
+		strings.Repeat("x", 40),
+	}
+
+	for _, v := range validNames {
+		_, errors := validateResourceName(40)(v, "name")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid MemoryDB resource name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1abc",
+		"-abc",
+		"abc-",
+		"a--b",
+		"ABC",
+		"a_b",
+		"a.b",
+		strings.Repeat("x", 41),
+	}
+
+	for _, v := range invalidNames {
+		_, errors := validateResourceName(40)(v, "name")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid MemoryDB resource name", v)
+		}
+	}
+}
+
+func TestValidateResourceNamePrefix(t *testing.T) {
+	validNamePrefixes := []string{
+		"a",
+		"a1",
+		"tf-acc-test-",
+		strings.Repeat("x", 14),
+	}
+
+	for _, v := range validNamePrefixes {
+		_, errors := validateResourceNamePrefix(14)(v, "name_prefix")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid MemoryDB resource name prefix: %q", v, errors)
+		}
+	}
+
+	invalidNamePrefixes := []string{
+		"",
+		"1abc",
+		"-abc",
+		"a--b",
+		"ABC",
+		strings.Repeat("x", 15),
+	}
+
+	for _, v := range invalidNamePrefixes {
+		_, errors := validateResourceNamePrefix(14)(v, "name_prefix")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid MemoryDB resource name prefix", v)
+		}
+	}
+}
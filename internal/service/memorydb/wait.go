@@ -0,0 +1,177 @@
+package memorydb
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	aclActiveTimeout  = 5 * time.Minute
+	aclDeletedTimeout = 5 * time.Minute
+
+	clusterAvailableTimeout = 120 * time.Minute
+	clusterDeletedTimeout   = 120 * time.Minute
+
+	clusterParameterGroupInSyncTimeout = 60 * time.Minute
+
+	clusterSecurityGroupsActiveTimeout = 10 * time.Minute
+
+	parameterGroupResetTimeout = 30 * time.Second
+
+	snapshotAvailableTimeout = 120 * time.Minute
+	snapshotDeletedTimeout   = 120 * time.Minute
+
+	userActiveTimeout  = 5 * time.Minute
+	userDeletedTimeout = 5 * time.Minute
+
+	clusterAvailableMinTimeout = 10 * time.Second
+	clusterAvailableDelay      = 30 * time.Second
+)
+
+// waitACLActive waits for MemoryDB ACL to reach an active state after modifications.
+func waitACLActive(conn *memorydb.MemoryDB, aclName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ACLStatusCreating, ACLStatusModifying},
+		Target:  []string{ACLStatusActive},
+		Refresh: statusACL(conn, aclName),
+		Timeout: aclActiveTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitACLDeleted waits for MemoryDB ACL to be deleted.
+func waitACLDeleted(conn *memorydb.MemoryDB, aclName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ACLStatusDeleting},
+		Target:  []string{},
+		Refresh: statusACL(conn, aclName),
+		Timeout: aclDeletedTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitClusterAvailable waits for MemoryDB Cluster to reach an active state after modifications.
+func waitClusterAvailable(conn *memorydb.MemoryDB, clusterName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ClusterStatusCreating, ClusterStatusUpdating, ClusterStatusSnapshotting},
+		Target:     []string{ClusterStatusAvailable},
+		Refresh:    statusCluster(conn, clusterName),
+		Timeout:    timeout,
+		MinTimeout: clusterAvailableMinTimeout,
+		Delay:      clusterAvailableDelay,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitClusterDeleted waits for MemoryDB Cluster to be deleted.
+func waitClusterDeleted(conn *memorydb.MemoryDB, clusterName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ClusterStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusCluster(conn, clusterName),
+		Timeout:    timeout,
+		MinTimeout: clusterAvailableMinTimeout,
+		Delay:      clusterAvailableDelay,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitClusterParameterGroupInSync waits for MemoryDB Cluster to come in sync
+// with a new parameter group.
+func waitClusterParameterGroupInSync(conn *memorydb.MemoryDB, clusterName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterParameterGroupStatusApplying},
+		Target:  []string{ClusterParameterGroupStatusInSync},
+		Refresh: statusClusterParameterGroup(conn, clusterName),
+		Timeout: clusterParameterGroupInSyncTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitClusterSecurityGroupsActive waits for MemoryDB Cluster to apply all
+// security group-related changes.
+func waitClusterSecurityGroupsActive(conn *memorydb.MemoryDB, clusterName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterSecurityGroupStatusModifying},
+		Target:  []string{ClusterSecurityGroupStatusActive},
+		Refresh: statusClusterSecurityGroups(conn, clusterName),
+		Timeout: clusterSecurityGroupsActiveTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitSnapshotAvailable waits for MemoryDB Snapshot to reach the available state.
+func waitSnapshotAvailable(conn *memorydb.MemoryDB, snapshotName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{SnapshotStatusCreating},
+		Target:  []string{SnapshotStatusAvailable},
+		Refresh: statusSnapshot(conn, snapshotName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitSnapshotDeleted waits for MemoryDB Snapshot to be deleted.
+func waitSnapshotDeleted(conn *memorydb.MemoryDB, snapshotName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{SnapshotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusSnapshot(conn, snapshotName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitUserActive waits for MemoryDB user to reach an active state after modifications.
+func waitUserActive(conn *memorydb.MemoryDB, userName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusModifying},
+		Target:  []string{UserStatusActive},
+		Refresh: statusUser(conn, userName),
+		Timeout: userActiveTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitUserDeleted waits for MemoryDB user to be deleted.
+func waitUserDeleted(conn *memorydb.MemoryDB, userName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusDeleting},
+		Target:  []string{},
+		Refresh: statusUser(conn, userName),
+		Timeout: userDeletedTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
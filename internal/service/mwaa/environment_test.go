@@ -358,7 +358,7 @@ func testAccCheckEnvironmentExists(resourceName string, environment *mwaa.GetEnv
 			return fmt.Errorf("No MWAA Environment ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn()
 		resp, err := conn.GetEnvironment(&mwaa.GetEnvironmentInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -374,7 +374,7 @@ func testAccCheckEnvironmentExists(resourceName string, environment *mwaa.GetEnv
 }
 
 func testAccCheckEnvironmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mwaa_environment" {
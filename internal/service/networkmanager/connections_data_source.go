@@ -0,0 +1,72 @@
+package networkmanager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceConnections() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.GetConnectionsInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("device_id"); ok {
+		input.DeviceId = aws.String(v.(string))
+	}
+
+	output, err := FindConnections(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error listing Network Manager Connections: %w", err)
+	}
+
+	var connectionIDs []string
+
+	for _, v := range output {
+		if len(tagsToMatch) > 0 {
+			if !KeyValueTags(v.Tags).ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		connectionIDs = append(connectionIDs, aws.StringValue(v.ConnectionId))
+	}
+
+	d.SetId(globalNetworkID)
+	d.Set("ids", connectionIDs)
+
+	return nil
+}
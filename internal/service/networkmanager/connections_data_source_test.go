@@ -0,0 +1,68 @@
+package networkmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerConnectionsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceAllName := "data.aws_networkmanager_connections.all"
+	dataSourceByTagsName := "data.aws_networkmanager_connections.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAllName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceByTagsName, "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnectionsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccConnectionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_networkmanager_connection" "test" {
+  global_network_id   = aws_networkmanager_global_network.test.id
+  device_id           = aws_networkmanager_device.test1.id
+  connected_device_id = aws_networkmanager_device.test2.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_connection" "other" {
+  global_network_id   = aws_networkmanager_global_network.test.id
+  device_id           = aws_networkmanager_device.test2.id
+  connected_device_id = aws_networkmanager_device.test1.id
+}
+
+data "aws_networkmanager_connections" "all" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  depends_on = [aws_networkmanager_connection.test, aws_networkmanager_connection.other]
+}
+
+data "aws_networkmanager_connections" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkmanager_connection.test, aws_networkmanager_connection.other]
+}
+`, rName))
+}
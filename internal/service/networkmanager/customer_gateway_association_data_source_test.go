@@ -0,0 +1,44 @@
+package networkmanager_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerCustomerGatewayAssociationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_networkmanager_customer_gateway_association.test"
+	resourceName := "aws_networkmanager_customer_gateway_association.test"
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomerGatewayAssociationDataSourceConfig(rName, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "customer_gateway_arn", resourceName, "customer_gateway_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "device_id", resourceName, "device_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "link_id", resourceName, "link_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", networkmanager.CustomerGatewayAssociationStateAvailable),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomerGatewayAssociationDataSourceConfig(rName string, rBgpAsn int) string {
+	return acctest.ConfigCompose(testAccCustomerGatewayAssociationConfig(rName, rBgpAsn), `
+data "aws_networkmanager_customer_gateway_association" "test" {
+  global_network_id    = aws_networkmanager_customer_gateway_association.test.global_network_id
+  customer_gateway_arn = aws_networkmanager_customer_gateway_association.test.customer_gateway_arn
+}
+`)
+}
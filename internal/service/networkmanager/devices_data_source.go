@@ -0,0 +1,72 @@
+package networkmanager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceDevices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceDevicesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.GetDevicesInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("site_id"); ok {
+		input.SiteId = aws.String(v.(string))
+	}
+
+	output, err := FindDevices(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error listing Network Manager Devices: %w", err)
+	}
+
+	var deviceIDs []string
+
+	for _, v := range output {
+		if len(tagsToMatch) > 0 {
+			if !KeyValueTags(v.Tags).ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		deviceIDs = append(deviceIDs, aws.StringValue(v.DeviceId))
+	}
+
+	d.SetId(globalNetworkID)
+	d.Set("ids", deviceIDs)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package networkmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerDevicesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceAllName := "data.aws_networkmanager_devices.all"
+	dataSourceByTagsName := "data.aws_networkmanager_devices.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevicesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAllName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceByTagsName, "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDevicesDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccGlobalNetworkBaseConfig(rName), fmt.Sprintf(`
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_device" "other" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+data "aws_networkmanager_devices" "all" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  depends_on = [aws_networkmanager_device.test, aws_networkmanager_device.other]
+}
+
+data "aws_networkmanager_devices" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkmanager_device.test, aws_networkmanager_device.other]
+}
+`, rName))
+}
@@ -0,0 +1,55 @@
+package networkmanager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceGlobalNetworks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGlobalNetworksRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceGlobalNetworksRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	output, err := FindGlobalNetworks(conn, &networkmanager.DescribeGlobalNetworksInput{})
+
+	if err != nil {
+		return fmt.Errorf("error listing Network Manager Global Networks: %w", err)
+	}
+
+	var globalNetworkIDs []string
+
+	for _, v := range output {
+		if len(tagsToMatch) > 0 {
+			if !KeyValueTags(v.Tags).ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		globalNetworkIDs = append(globalNetworkIDs, aws.StringValue(v.GlobalNetworkId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", globalNetworkIDs)
+
+	return nil
+}
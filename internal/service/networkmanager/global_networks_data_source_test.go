@@ -0,0 +1,56 @@
+package networkmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerGlobalNetworksDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceAllName := "data.aws_networkmanager_global_networks.all"
+	dataSourceByTagsName := "data.aws_networkmanager_global_networks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalNetworksDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceAllName, "ids.#"),
+					resource.TestCheckResourceAttr(dataSourceByTagsName, "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGlobalNetworksDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test1" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_global_network" "test2" {}
+
+data "aws_networkmanager_global_networks" "all" {
+  depends_on = [aws_networkmanager_global_network.test1, aws_networkmanager_global_network.test2]
+}
+
+data "aws_networkmanager_global_networks" "test" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkmanager_global_network.test1, aws_networkmanager_global_network.test2]
+}
+`, rName)
+}
@@ -0,0 +1,88 @@
+package networkmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const linkAssociationIDSeparator = ","
+
+func LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID string) string {
+	parts := []string{globalNetworkID, linkID, deviceID}
+	id := strings.Join(parts, linkAssociationIDSeparator)
+
+	return id
+}
+
+func LinkAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, linkAssociationIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL-NETWORK-ID%[2]sLINK-ID%[2]sDEVICE-ID", id, linkAssociationIDSeparator)
+}
+
+const customerGatewayAssociationIDSeparator = ","
+
+func CustomerGatewayAssociationCreateResourceID(globalNetworkID, customerGatewayARN string) string {
+	parts := []string{globalNetworkID, customerGatewayARN}
+	id := strings.Join(parts, customerGatewayAssociationIDSeparator)
+
+	return id
+}
+
+func CustomerGatewayAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, customerGatewayAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL-NETWORK-ID%[2]sCUSTOMER-GATEWAY-ARN", id, customerGatewayAssociationIDSeparator)
+}
+
+const transitGatewayRegistrationIDSeparator = ","
+
+func TransitGatewayRegistrationCreateResourceID(globalNetworkID, transitGatewayARN string) string {
+	parts := []string{globalNetworkID, transitGatewayARN}
+	id := strings.Join(parts, transitGatewayRegistrationIDSeparator)
+
+	return id
+}
+
+func TransitGatewayRegistrationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayRegistrationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL-NETWORK-ID%[2]sTRANSIT-GATEWAY-ARN", id, transitGatewayRegistrationIDSeparator)
+}
+
+// importByARN imports a resource belonging to a global network by its ARN, e.g.
+// arn:aws:networkmanager::123456789012:site/global-network-0d47f6t230mz46dy4/site-444555aaabbb11223.
+func importByARN(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parsedARN, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ARN (%s): %w", d.Id(), err)
+	}
+
+	// e.g. site/global-network-0d47f6t230mz46dy4/site-444555aaabbb11223
+	resourceParts := strings.Split(parsedARN.Resource, "/")
+
+	if len(resourceParts) != 3 || resourceParts[1] == "" || resourceParts[2] == "" {
+		return nil, fmt.Errorf("unexpected format for ARN (%s)", d.Id())
+	}
+
+	d.SetId(resourceParts[2])
+	d.Set("global_network_id", resourceParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,90 @@
+package networkmanager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceLinkAssociation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinkAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"device_id", "link_id"},
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"link_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"device_id", "link_id"},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLinkAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
+
+	input := &networkmanager.GetLinkAssociationsInput{
+		GlobalNetworkId: aws.String(d.Get("global_network_id").(string)),
+	}
+
+	if v, ok := d.GetOk("device_id"); ok {
+		input.DeviceId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("link_id"); ok {
+		input.LinkId = aws.String(v.(string))
+	}
+
+	output, err := FindLinkAssociations(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Link Associations: %w", err)
+	}
+
+	var linkAssociations []*networkmanager.LinkAssociation
+
+	for _, v := range output {
+		if aws.StringValue(v.LinkAssociationState) != networkmanager.LinkAssociationStateDeleted {
+			linkAssociations = append(linkAssociations, v)
+		}
+	}
+
+	if len(linkAssociations) == 0 {
+		return fmt.Errorf("no matching Network Manager Link Association found")
+	}
+
+	if len(linkAssociations) > 1 {
+		return fmt.Errorf("multiple Network Manager Link Associations matched; use additional constraints to reduce matches to a single Link Association")
+	}
+
+	linkAssociation := linkAssociations[0]
+	globalNetworkID := aws.StringValue(linkAssociation.GlobalNetworkId)
+	linkID := aws.StringValue(linkAssociation.LinkId)
+	deviceID := aws.StringValue(linkAssociation.DeviceId)
+
+	d.SetId(LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID))
+	d.Set("device_id", deviceID)
+	d.Set("global_network_id", globalNetworkID)
+	d.Set("link_id", linkID)
+	d.Set("state", linkAssociation.LinkAssociationState)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package networkmanager_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerLinkAssociationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceByDeviceName := "data.aws_networkmanager_link_association.by_device"
+	dataSourceByLinkName := "data.aws_networkmanager_link_association.by_link"
+	resourceName := "aws_networkmanager_link_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkAssociationDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByDeviceName, "device_id", resourceName, "device_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByDeviceName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByDeviceName, "link_id", resourceName, "link_id"),
+					resource.TestCheckResourceAttr(dataSourceByDeviceName, "state", networkmanager.LinkAssociationStateAvailable),
+					resource.TestCheckResourceAttrPair(dataSourceByLinkName, "device_id", resourceName, "device_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByLinkName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByLinkName, "link_id", resourceName, "link_id"),
+					resource.TestCheckResourceAttr(dataSourceByLinkName, "state", networkmanager.LinkAssociationStateAvailable),
+				),
+			},
+		},
+	})
+}
+
+func testAccLinkAssociationDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccLinkAssociationConfig(rName), `
+data "aws_networkmanager_link_association" "by_device" {
+  global_network_id = aws_networkmanager_link_association.test.global_network_id
+  device_id         = aws_networkmanager_link_association.test.device_id
+}
+
+data "aws_networkmanager_link_association" "by_link" {
+  global_network_id = aws_networkmanager_link_association.test.global_network_id
+  link_id           = aws_networkmanager_link_association.test.link_id
+}
+`)
+}
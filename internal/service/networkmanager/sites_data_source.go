@@ -0,0 +1,62 @@
+package networkmanager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceSites() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSitesRead,
+
+		Schema: map[string]*schema.Schema{
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceSitesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	output, err := FindSites(conn, &networkmanager.GetSitesInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Network Manager Sites: %w", err)
+	}
+
+	var siteIDs []string
+
+	for _, v := range output {
+		if len(tagsToMatch) > 0 {
+			if !KeyValueTags(v.Tags).ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		siteIDs = append(siteIDs, aws.StringValue(v.SiteId))
+	}
+
+	d.SetId(globalNetworkID)
+	d.Set("ids", siteIDs)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package networkmanager_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerTransitGatewayRegistrationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_networkmanager_transit_gateway_registration.test"
+	resourceName := "aws_networkmanager_transit_gateway_registration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRegistrationDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", networkmanager.TransitGatewayRegistrationStateAvailable),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateway_arn", resourceName, "transit_gateway_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRegistrationDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRegistrationConfig(rName), `
+data "aws_networkmanager_transit_gateway_registration" "test" {
+  global_network_id   = aws_networkmanager_transit_gateway_registration.test.global_network_id
+  transit_gateway_arn = aws_networkmanager_transit_gateway_registration.test.transit_gateway_arn
+}
+`)
+}
@@ -28,7 +28,7 @@ func sweepLedgers(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).QLDBConn
+	conn := client.(*conns.AWSClient).QLDBConn()
 	input := &qldb.ListLedgersInput{}
 	page, err := conn.ListLedgers(input)
 
@@ -0,0 +1,243 @@
+package route53
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+const (
+	// changeBatchWindow is how long the first change to a hosted zone waits for concurrent changes to join its batch.
+	changeBatchWindow = 1 * time.Second
+
+	// Route 53 accepts at most 1,000 ResourceRecord elements and 32,000 characters of record values per request.
+	// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+	changeBatchMaxRecords = 1000
+	changeBatchMaxChars   = 32000
+)
+
+// recordChangeBatcher coalesces the record changes of all aws_route53_record resources.
+var recordChangeBatcher = newChangeBatcher(changeBatchWindow)
+
+// changeBatcher coalesces concurrent ChangeResourceRecordSets requests for the same hosted zone into a single change batch.
+// Route 53 limits ChangeResourceRecordSets to five requests per second per account,
+// so zones with many records otherwise spend most of an apply being throttled.
+type changeBatcher struct {
+	mu      sync.Mutex
+	batches map[changeBatchKey]*changeBatch
+	window  time.Duration
+}
+
+type changeBatchKey struct {
+	conn         *route53.Route53
+	hostedZoneID string
+}
+
+type changeBatch struct {
+	chars    int
+	records  int
+	requests []*changeBatchRequest
+	sets     map[string]struct{}
+}
+
+type changeBatchRequest struct {
+	changes []*route53.Change
+	result  chan changeBatchResult
+}
+
+type changeBatchResult struct {
+	changeInfo *route53.ChangeInfo
+	err        error
+}
+
+func newChangeBatcher(window time.Duration) *changeBatcher {
+	return &changeBatcher{
+		batches: make(map[changeBatchKey]*changeBatch),
+		window:  window,
+	}
+}
+
+// ChangeResourceRecordSets submits the specified changes as part of the next change batch for the hosted zone and
+// returns the batch's change information once the batch has been sent.
+// The changes of a single call are always sent together and so are applied transactionally.
+// If a batch fails other than by being throttled, each of its requests is resent on its own
+// so that every caller receives its own error.
+func (b *changeBatcher) ChangeResourceRecordSets(conn *route53.Route53, hostedZoneID string, changes []*route53.Change) (*route53.ChangeInfo, error) {
+	key := changeBatchKey{
+		conn:         conn,
+		hostedZoneID: CleanZoneID(hostedZoneID),
+	}
+	request := &changeBatchRequest{
+		changes: changes,
+		result:  make(chan changeBatchResult, 1),
+	}
+
+	b.mu.Lock()
+
+	batch := b.batches[key]
+
+	// Changes to the same record set are never combined, and a full batch is sent right away.
+	if batch != nil && !batch.accepts(request) {
+		delete(b.batches, key)
+		go b.send(key, batch)
+		batch = nil
+	}
+
+	if batch == nil {
+		batch = &changeBatch{
+			sets: make(map[string]struct{}),
+		}
+		b.batches[key] = batch
+
+		time.AfterFunc(b.window, func() {
+			b.flush(key, batch)
+		})
+	}
+
+	batch.add(request)
+
+	b.mu.Unlock()
+
+	result := <-request.result
+
+	return result.changeInfo, result.err
+}
+
+// flush sends the specified batch, unless it has already been sent.
+func (b *changeBatcher) flush(key changeBatchKey, batch *changeBatch) {
+	b.mu.Lock()
+
+	if b.batches[key] != batch {
+		b.mu.Unlock()
+		return
+	}
+
+	delete(b.batches, key)
+
+	b.mu.Unlock()
+
+	b.send(key, batch)
+}
+
+func (b *changeBatcher) send(key changeBatchKey, batch *changeBatch) {
+	var changes []*route53.Change
+
+	for _, request := range batch.requests {
+		changes = append(changes, request.changes...)
+	}
+
+	log.Printf("[DEBUG] Changing Route 53 Hosted Zone (%s) record sets: %d requests, %d changes", key.hostedZoneID, len(batch.requests), len(changes))
+	changeInfo, err := changeResourceRecordSets(key.conn, key.hostedZoneID, changes)
+
+	// e.g. InvalidChangeBatch or InvalidInput caused by one of the requests.
+	// Resending a throttled batch's requests separately would only add to the throttling.
+	if len(batch.requests) > 1 && err != nil && !request.IsErrorThrottle(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) change batch failed, sending %d requests separately: %s", key.hostedZoneID, len(batch.requests), err)
+
+		for _, request := range batch.requests {
+			changeInfo, err := changeResourceRecordSets(key.conn, key.hostedZoneID, request.changes)
+
+			request.result <- changeBatchResult{changeInfo: changeInfo, err: err}
+		}
+
+		return
+	}
+
+	for _, request := range batch.requests {
+		request.result <- changeBatchResult{changeInfo: changeInfo, err: err}
+	}
+}
+
+func changeResourceRecordSets(conn *route53.Route53, hostedZoneID string, changes []*route53.Change) (*route53.ChangeInfo, error) {
+	input := &route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: changes,
+			Comment: aws.String("Managed by Terraform"),
+		},
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+
+	outputRaw, err := ChangeRecordSet(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, ok := outputRaw.(*route53.ChangeResourceRecordSetsOutput)
+
+	if !ok || output == nil {
+		return nil, nil
+	}
+
+	return output.ChangeInfo, nil
+}
+
+// accepts returns whether the request can be added to the batch.
+func (batch *changeBatch) accepts(request *changeBatchRequest) bool {
+	records, chars := changeBatchSize(request.changes)
+
+	if batch.records+records > changeBatchMaxRecords || batch.chars+chars > changeBatchMaxChars {
+		return false
+	}
+
+	for _, change := range request.changes {
+		if _, ok := batch.sets[changeBatchRecordSetKey(change.ResourceRecordSet)]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (batch *changeBatch) add(request *changeBatchRequest) {
+	records, chars := changeBatchSize(request.changes)
+
+	batch.chars += chars
+	batch.records += records
+	batch.requests = append(batch.requests, request)
+
+	for _, change := range request.changes {
+		batch.sets[changeBatchRecordSetKey(change.ResourceRecordSet)] = struct{}{}
+	}
+}
+
+// changeBatchSize returns the number of ResourceRecord elements and characters of record values that count towards
+// the ChangeResourceRecordSets request limits. UPSERT changes count twice.
+func changeBatchSize(changes []*route53.Change) (int, int) {
+	var records, chars int
+
+	for _, change := range changes {
+		n := 1
+
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			n = 2
+		}
+
+		if rrset := change.ResourceRecordSet; rrset != nil {
+			if len(rrset.ResourceRecords) == 0 {
+				records += n
+			}
+
+			for _, rr := range rrset.ResourceRecords {
+				records += n
+				chars += n * len(aws.StringValue(rr.Value))
+			}
+		}
+	}
+
+	return records, chars
+}
+
+func changeBatchRecordSetKey(rrset *route53.ResourceRecordSet) string {
+	if rrset == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s|%s|%s", strings.ToLower(FQDN(aws.StringValue(rrset.Name))), aws.StringValue(rrset.Type), aws.StringValue(rrset.SetIdentifier))
+}
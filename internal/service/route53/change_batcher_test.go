@@ -0,0 +1,252 @@
+package route53
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// testRoute53Server is a minimal local stand-in for the Route 53 ChangeResourceRecordSets API.
+// Change batches containing a record set whose name starts with "invalid" or "denied" are rejected,
+// and those containing a record set whose name starts with "throttled" are throttled.
+type testRoute53Server struct {
+	sync.Mutex
+
+	batches [][]string
+}
+
+func (s *testRoute53Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Changes []struct {
+			Name string `xml:"ResourceRecordSet>Name"`
+		} `xml:"ChangeBatch>Changes>Change"`
+	}
+
+	if err := xml.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.Lock()
+	var names []string
+	for _, change := range body.Changes {
+		names = append(names, change.Name)
+	}
+	s.batches = append(s.batches, names)
+	id := len(s.batches)
+	s.Unlock()
+
+	for _, name := range names {
+		switch {
+		case strings.HasPrefix(name, "invalid"):
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `<InvalidChangeBatch><Messages><Message>Invalid record set %s</Message></Messages></InvalidChangeBatch>`, name)
+			return
+		case strings.HasPrefix(name, "denied"):
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>Not authorized to change record set %s</Message></Error></ErrorResponse>`, name)
+			return
+		case strings.HasPrefix(name, "throttled"):
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error></ErrorResponse>`)
+			return
+		}
+	}
+
+	fmt.Fprintf(w, `<ChangeResourceRecordSetsResponse><ChangeInfo><Id>/change/C%d</Id><Status>PENDING</Status><SubmittedAt>2021-11-01T00:00:00Z</SubmittedAt></ChangeInfo></ChangeResourceRecordSetsResponse>`, id)
+}
+
+func testRoute53Conn(t *testing.T, server *httptest.Server) *route53.Route53 {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("test", "test", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-east-1"),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return route53.New(sess)
+}
+
+func testRecordChange(name string) []*route53.Change {
+	return []*route53.Change{
+		{
+			Action: aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name:            aws.String(name),
+				ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("127.0.0.1")}},
+				TTL:             aws.Int64(300),
+				Type:            aws.String(route53.RRTypeA),
+			},
+		},
+	}
+}
+
+func TestChangeBatcher(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		RecordNames     []string
+		ExpectedBatches int
+		ExpectedErrors  map[string]string // record name to error code
+	}{
+		{
+			Name:            "single change",
+			RecordNames:     []string{"a.example.com"},
+			ExpectedBatches: 1,
+		},
+		{
+			Name:            "concurrent changes",
+			RecordNames:     []string{"a.example.com", "b.example.com", "c.example.com"},
+			ExpectedBatches: 1,
+		},
+		{
+			Name:            "same record set",
+			RecordNames:     []string{"a.example.com", "A.example.com."},
+			ExpectedBatches: 2,
+		},
+		{
+			Name:            "invalid change",
+			RecordNames:     []string{"a.example.com", "invalid.example.com", "c.example.com"},
+			ExpectedBatches: 4,
+			ExpectedErrors: map[string]string{
+				"invalid.example.com": route53.ErrCodeInvalidChangeBatch,
+			},
+		},
+		{
+			Name:            "denied change",
+			RecordNames:     []string{"a.example.com", "denied.example.com", "c.example.com"},
+			ExpectedBatches: 4,
+			ExpectedErrors: map[string]string{
+				"denied.example.com": "AccessDenied",
+			},
+		},
+		{
+			Name:            "throttled batch",
+			RecordNames:     []string{"a.example.com", "throttled.example.com", "c.example.com"},
+			ExpectedBatches: 1,
+			ExpectedErrors: map[string]string{
+				"a.example.com":         "Throttling",
+				"throttled.example.com": "Throttling",
+				"c.example.com":         "Throttling",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			handler := &testRoute53Server{}
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			conn := testRoute53Conn(t, server)
+			batcher := newChangeBatcher(200 * time.Millisecond)
+
+			var wg sync.WaitGroup
+			changeIDs := make([]string, len(testCase.RecordNames))
+			errs := make([]error, len(testCase.RecordNames))
+
+			for i, name := range testCase.RecordNames {
+				wg.Add(1)
+
+				go func(i int, name string) {
+					defer wg.Done()
+
+					changeInfo, err := batcher.ChangeResourceRecordSets(conn, "/hostedzone/Z123", testRecordChange(name))
+
+					if changeInfo != nil {
+						changeIDs[i] = aws.StringValue(changeInfo.Id)
+					}
+					errs[i] = err
+				}(i, name)
+			}
+
+			wg.Wait()
+
+			if got, want := len(handler.batches), testCase.ExpectedBatches; got != want {
+				t.Errorf("got %d change batches (%v), want %d", got, handler.batches, want)
+			}
+
+			for i, name := range testCase.RecordNames {
+				if code, ok := testCase.ExpectedErrors[name]; ok {
+					if !tfawserr.ErrCodeEquals(errs[i], code) {
+						t.Errorf("%s: expected %s error, got %v", name, code, errs[i])
+					}
+
+					continue
+				}
+
+				if errs[i] != nil {
+					t.Errorf("%s: unexpected error: %s", name, errs[i])
+				}
+
+				if changeIDs[i] == "" {
+					t.Errorf("%s: expected change ID", name)
+				}
+			}
+
+			if testCase.ExpectedBatches == 1 && len(testCase.ExpectedErrors) == 0 {
+				for i := range changeIDs {
+					if changeIDs[i] != changeIDs[0] {
+						t.Errorf("expected a single change ID, got %v", changeIDs)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestChangeBatcherLimits(t *testing.T) {
+	handler := &testRoute53Server{}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	conn := testRoute53Conn(t, server)
+	batcher := newChangeBatcher(200 * time.Millisecond)
+
+	// Each UPSERT of 300 records counts as 600 towards the limit of 1,000 records per batch.
+	var changes [][]*route53.Change
+
+	for i := 0; i < 2; i++ {
+		change := testRecordChange(fmt.Sprintf("r%d.example.com", i))
+		for j := 1; j < 300; j++ {
+			rrset := change[0].ResourceRecordSet
+			rrset.ResourceRecords = append(rrset.ResourceRecords, &route53.ResourceRecord{Value: aws.String(fmt.Sprintf("10.0.%d.%d", j/256, j%256))})
+		}
+		changes = append(changes, change)
+	}
+
+	var wg sync.WaitGroup
+
+	for _, change := range changes {
+		wg.Add(1)
+
+		go func(change []*route53.Change) {
+			defer wg.Done()
+
+			if _, err := batcher.ChangeResourceRecordSets(conn, "Z123", change); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}(change)
+	}
+
+	wg.Wait()
+
+	if got, want := len(handler.batches), 2; got != want {
+		t.Errorf("got %d change batches, want %d", got, want)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -273,7 +274,7 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	// Otherwise, we delete the existing record and create a new record within
 	// a transactional change.
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	zone := CleanZoneID(d.Get("zone_id").(string))
 
 	var err error
@@ -346,38 +347,27 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// Delete the old and create the new records in a single batch. We abuse
-	// StateChangeConf for this to retry for us since Route53 sometimes returns
-	// errors about another operation happening at the same time.
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(route53.ChangeActionDelete),
-				ResourceRecordSet: oldRec,
-			},
-			{
-				Action:            aws.String(route53.ChangeActionCreate),
-				ResourceRecordSet: rec,
-			},
+	// Delete the old and create the new records in a single transactional
+	// request, which may be batched with concurrent changes to the same zone.
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: oldRec,
+		},
+		{
+			Action:            aws.String(route53.ChangeActionCreate),
+			ResourceRecordSet: rec,
 		},
-	}
-
-	input := &route53.ChangeResourceRecordSetsInput{
-		HostedZoneId: aws.String(CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))),
-		ChangeBatch:  changeBatch,
 	}
 
 	log.Printf("[DEBUG] Updating resource records for zone: %s, name: %s\n\n%s",
-		zone, aws.StringValue(rec.Name), input)
+		zone, aws.StringValue(rec.Name), changes)
 
-	respRaw, err := ChangeRecordSet(conn, input)
+	changeInfo, err := recordChangeBatcher.ChangeResourceRecordSets(conn, aws.StringValue(zoneRecord.HostedZone.Id), changes)
 	if err != nil {
 		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
 	}
 
-	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
-
 	// Generate an ID
 	vars := []string{
 		zone,
@@ -400,7 +390,7 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	zone := CleanZoneID(d.Get("zone_id").(string))
 
 	var err error
@@ -428,34 +418,23 @@ func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
 		action = route53.ChangeActionCreate
 	}
 
-	// Create the new records. We abuse StateChangeConf for this to
-	// retry for us since Route53 sometimes returns errors about another
-	// operation happening at the same time.
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(action),
-				ResourceRecordSet: rec,
-			},
+	// Create the new records. The change may be batched with concurrent
+	// changes to the same zone to stay within the Route 53 API rate limit.
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(action),
+			ResourceRecordSet: rec,
 		},
 	}
 
-	req := &route53.ChangeResourceRecordSetsInput{
-		HostedZoneId: aws.String(CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))),
-		ChangeBatch:  changeBatch,
-	}
-
 	log.Printf("[DEBUG] Creating resource records for zone: %s, name: %s\n\n%s",
-		zone, aws.StringValue(rec.Name), req)
+		zone, aws.StringValue(rec.Name), changes)
 
-	respRaw, err := ChangeRecordSet(conn, req)
+	changeInfo, err := recordChangeBatcher.ChangeResourceRecordSets(conn, aws.StringValue(zoneRecord.HostedZone.Id), changes)
 	if err != nil {
 		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
 	}
 
-	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
-
 	// Generate an ID
 	vars := []string{
 		zone,
@@ -498,7 +477,45 @@ func ChangeRecordSet(conn *route53.Route53, input *route53.ChangeResourceRecordS
 	return out, err
 }
 
+// WaitForRecordSetToSync waits for the specified change to be INSYNC.
+// Concurrent waits for the same change, e.g. by the records of one change batch, share a single poller.
 func WaitForRecordSetToSync(conn *route53.Route53, requestId string) error {
+	recordSetSyncWaiters.Lock()
+	waiter, ok := recordSetSyncWaiters.m[requestId]
+	if !ok {
+		waiter = &recordSetSyncWaiter{done: make(chan struct{})}
+		recordSetSyncWaiters.m[requestId] = waiter
+	}
+	recordSetSyncWaiters.Unlock()
+
+	if ok {
+		<-waiter.done
+		return waiter.err
+	}
+
+	waiter.err = waitForRecordSetToSync(conn, requestId)
+	close(waiter.done)
+
+	recordSetSyncWaiters.Lock()
+	delete(recordSetSyncWaiters.m, requestId)
+	recordSetSyncWaiters.Unlock()
+
+	return waiter.err
+}
+
+type recordSetSyncWaiter struct {
+	done chan struct{}
+	err  error
+}
+
+var recordSetSyncWaiters = struct {
+	sync.Mutex
+	m map[string]*recordSetSyncWaiter
+}{
+	m: make(map[string]*recordSetSyncWaiter),
+}
+
+func waitForRecordSetToSync(conn *route53.Route53, requestId string) error {
 	rand.Seed(time.Now().UTC().UnixNano())
 
 	wait := resource.StateChangeConf{
@@ -633,7 +650,7 @@ func resourceRecordRead(d *schema.ResourceData, meta interface{}) error {
 // If there are other errors, it returns a nil recordset and passes on the
 // error.
 func findRecord(d *schema.ResourceData, meta interface{}) (*route53.ResourceRecordSet, error) {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	// Scan for a
 	zone := CleanZoneID(d.Get("zone_id").(string))
 
@@ -735,7 +752,7 @@ func findRecord(d *schema.ResourceData, meta interface{}) (*route53.ResourceReco
 }
 
 func resourceRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	// Get the records
 	rec, err := findRecord(d, meta)
 	if err != nil {
@@ -747,30 +764,23 @@ func resourceRecordDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	// Change batch for deleting
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Deleted by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(route53.ChangeActionDelete),
-				ResourceRecordSet: rec,
-			},
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: rec,
 		},
 	}
 
 	zone := CleanZoneID(d.Get("zone_id").(string))
 
-	req := &route53.ChangeResourceRecordSetsInput{
-		HostedZoneId: aws.String(CleanZoneID(zone)),
-		ChangeBatch:  changeBatch,
+	changeInfo, err := recordChangeBatcher.ChangeResourceRecordSets(conn, zone, changes)
+	if tfawserr.ErrMessageContains(err, route53.ErrCodeInvalidChangeBatch, "") {
+		changeInfo, err = nil, nil
 	}
-
-	respRaw, err := DeleteRecordSet(conn, req)
 	if err != nil {
 		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
 	}
 
-	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
 	if changeInfo == nil {
 		log.Printf("[INFO] No ChangeInfo Found. Waiting for Sync not required")
 		return nil
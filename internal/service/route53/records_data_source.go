@@ -0,0 +1,200 @@
+package route53
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"resource_record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"failover": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"multivalue_answer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	var nameRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	rrType := d.Get("type").(string)
+
+	var recordSets []*route53.ResourceRecordSet
+
+	err := conn.ListResourceRecordSetsPages(input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v == nil {
+				continue
+			}
+
+			if rrType != "" && aws.StringValue(v.Type) != rrType {
+				continue
+			}
+
+			if nameRegex != nil && !nameRegex.MatchString(flattenRecordSetName(aws.StringValue(v.Name))) {
+				continue
+			}
+
+			recordSets = append(recordSets, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Hosted Zone (%s) record sets: %w", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	if err := d.Set("resource_record_sets", flattenResourceRecordSets(recordSets)); err != nil {
+		return fmt.Errorf("error setting resource_record_sets: %w", err)
+	}
+
+	return nil
+}
+
+// flattenRecordSetName returns a record set name as returned by the Route 53 API without the trailing dot and
+// with escaped characters, such as "*", unescaped.
+func flattenRecordSetName(name string) string {
+	return strings.TrimSuffix(CleanRecordName(name), ".")
+}
+
+func flattenResourceRecordSets(apiObjects []*route53.ResourceRecordSet) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		rrType := aws.StringValue(apiObject.Type)
+		tfMap := map[string]interface{}{
+			"failover":          aws.StringValue(apiObject.Failover),
+			"health_check_id":   aws.StringValue(apiObject.HealthCheckId),
+			"multivalue_answer": aws.BoolValue(apiObject.MultiValueAnswer),
+			"name":              flattenRecordSetName(aws.StringValue(apiObject.Name)),
+			"records":           FlattenResourceRecords(apiObject.ResourceRecords, rrType),
+			"region":            aws.StringValue(apiObject.Region),
+			"set_identifier":    aws.StringValue(apiObject.SetIdentifier),
+			"ttl":               aws.Int64Value(apiObject.TTL),
+			"type":              rrType,
+			"weight":            aws.Int64Value(apiObject.Weight),
+		}
+
+		if alias := apiObject.AliasTarget; alias != nil {
+			tfMap["alias"] = []interface{}{
+				map[string]interface{}{
+					"evaluate_target_health": aws.BoolValue(alias.EvaluateTargetHealth),
+					"name":                   NormalizeAliasName(aws.StringValue(alias.DNSName)),
+					"zone_id":                aws.StringValue(alias.HostedZoneId),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
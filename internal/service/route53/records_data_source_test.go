@@ -0,0 +1,75 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoute53RecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig(zoneName, `type = "A"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.name", fmt.Sprintf("record0.%s", zoneName)),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.0", "127.0.0.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.ttl", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.type", "A"),
+				),
+			},
+			{
+				Config: testAccRecordsDataSourceConfig(zoneName, `name_regex = "^record[0-2]\\."`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "3"),
+				),
+			},
+			{
+				Config: testAccRecordsDataSourceConfig(zoneName, `type = "NS"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.name", zoneName),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig(zoneName, filter string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "test" {
+  count = 5
+
+  name    = "record${count.index}.${aws_route53_zone.test.name}"
+  records = ["127.0.0.${count.index}"]
+  ttl     = "30"
+  type    = "A"
+  zone_id = aws_route53_zone.test.zone_id
+}
+
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  %[2]s
+
+  depends_on = [aws_route53_record.test]
+}
+`, zoneName, filter)
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -156,14 +157,40 @@ func ResourceBucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption. The Etag then won't match raw-file MD5.
+				// For multipart uploads the MD5 is computed locally, see multipart_etag.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"kms_key_id"},
 			},
 
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      bucketObjectMultipartConcurrencyDefault,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"multipart_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      bucketObjectMultipartPartSizeDefault,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      bucketObjectMultipartThresholdDefault,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -210,7 +237,7 @@ func ResourceBucketObject() *schema.Resource {
 }
 
 func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -320,10 +347,23 @@ func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 		putInput.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
 	}
 
-	if _, err := conn.PutObject(putInput); err != nil {
+	uploadOptions := bucketObjectUploadOptions{
+		Concurrency: d.Get("multipart_concurrency").(int),
+		PartSize:    int64(d.Get("multipart_part_size").(int)),
+		Threshold:   int64(d.Get("multipart_threshold").(int)),
+	}
+
+	output, err := uploadBucketObject(conn, putInput, uploadOptions)
+
+	if err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
+	if output.MultipartETag != "" {
+		d.Set("etag", output.ETag)
+	}
+	d.Set("multipart_etag", output.MultipartETag)
+
 	d.SetId(key)
 	return resourceBucketObjectRead(d, meta)
 }
@@ -333,7 +373,7 @@ func resourceBucketObjectCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -408,7 +448,14 @@ func resourceBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(aws.StringValue(resp.ETag), `"`))
+	etag := strings.Trim(aws.StringValue(resp.ETag), `"`)
+
+	// The ETag of a multipart upload is not the MD5 of the object.
+	// Keep the MD5 computed during the upload for as long as the object is unchanged.
+	if v := d.Get("multipart_etag").(string); v == "" || v != etag {
+		d.Set("etag", etag)
+		d.Set("multipart_etag", "")
+	}
 
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
@@ -451,7 +498,7 @@ func resourceBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error
 		return resourceBucketObjectPut(d, meta)
 	}
 
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -518,7 +565,7 @@ func resourceBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -556,6 +603,9 @@ func resourceBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*sc
 	d.SetId(key)
 	d.Set("bucket", bucket)
 	d.Set("key", key)
+	d.Set("multipart_concurrency", bucketObjectMultipartConcurrencyDefault)
+	d.Set("multipart_part_size", bucketObjectMultipartPartSizeDefault)
+	d.Set("multipart_threshold", bucketObjectMultipartThresholdDefault)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -564,7 +614,7 @@ func resourceBucketObjectSetKMS(d *schema.ResourceData, meta interface{}, sseKMS
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if sseKMSKeyId != nil {
 		// retrieve S3 KMS Default Master Key
-		conn := meta.(*conns.AWSClient).KMSConn
+		conn := meta.(*conns.AWSClient).KMSConn()
 		keyMetadata, err := kms.FindKeyByID(conn, DefaultKmsKeyAlias)
 		if err != nil {
 			return fmt.Errorf("Failed to describe default S3 KMS key (%s): %s", DefaultKmsKeyAlias, err)
@@ -593,6 +643,10 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceBucketObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasS3BucketObjectContentChanges(d) {
+		if err := d.SetNewComputed("multipart_etag"); err != nil {
+			return err
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
@@ -0,0 +1,134 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const (
+	bucketObjectMultipartThresholdDefault   = 100 * 1024 * 1024
+	bucketObjectMultipartPartSizeDefault    = 16 * 1024 * 1024
+	bucketObjectMultipartConcurrencyDefault = s3manager.DefaultUploadConcurrency
+)
+
+type bucketObjectUploadOptions struct {
+	Concurrency int
+	PartSize    int64
+	Threshold   int64
+}
+
+type bucketObjectUploadOutput struct {
+	// ETag is the MD5 digest of the object body, as a hex string.
+	ETag string
+	// MultipartETag is the ETag that S3 assigned to a multipart upload.
+	// It is empty if the object was uploaded with a single PutObject call.
+	MultipartETag string
+}
+
+// uploadBucketObject uploads the body of the specified PutObject request.
+// Bodies smaller than the multipart threshold are uploaded with a single PutObject call.
+// Larger bodies are uploaded in parts, in parallel, and the multipart upload is aborted if any part fails.
+func uploadBucketObject(conn s3iface.S3API, input *s3.PutObjectInput, options bucketObjectUploadOptions) (*bucketObjectUploadOutput, error) {
+	output := &bucketObjectUploadOutput{}
+
+	if input.Body == nil {
+		input.Body = strings.NewReader("")
+	}
+
+	size, err := input.Body.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return nil, fmt.Errorf("error determining object size: %w", err)
+	}
+
+	if _, err := input.Body.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	if size < options.Threshold {
+		putOutput, err := conn.PutObject(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output.ETag = strings.Trim(aws.StringValue(putOutput.ETag), `"`)
+
+		return output, nil
+	}
+
+	// A multipart upload's ETag is not the MD5 digest of the object, so compute it separately.
+	hash := md5.New()
+
+	if _, err := io.Copy(hash, input.Body); err != nil {
+		return nil, fmt.Errorf("error computing object MD5: %w", err)
+	}
+
+	if _, err := input.Body.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	output.ETag = hex.EncodeToString(hash.Sum(nil))
+
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.Concurrency = options.Concurrency
+		u.LeavePartsOnError = false
+		u.PartSize = options.PartSize
+	})
+
+	log.Printf("[DEBUG] Uploading S3 Bucket Object (%s/%s) in parts: %d bytes", aws.StringValue(input.Bucket), aws.StringValue(input.Key), size)
+	uploadOutput, err := uploader.Upload(expandUploadInput(input))
+
+	if err != nil {
+		if v, ok := err.(s3manager.MultiUploadFailure); ok {
+			return nil, fmt.Errorf("multipart upload (%s) failed and was aborted: %w", v.UploadID(), err)
+		}
+
+		return nil, err
+	}
+
+	if v := strings.Trim(aws.StringValue(uploadOutput.ETag), `"`); isMultipartETag(v) {
+		output.MultipartETag = v
+	}
+
+	return output, nil
+}
+
+// isMultipartETag returns whether the specified ETag was assigned to a multipart upload.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_Object.html.
+func isMultipartETag(etag string) bool {
+	return strings.Contains(etag, "-")
+}
+
+func expandUploadInput(input *s3.PutObjectInput) *s3manager.UploadInput {
+	return &s3manager.UploadInput{
+		ACL:                       input.ACL,
+		Body:                      input.Body,
+		Bucket:                    input.Bucket,
+		BucketKeyEnabled:          input.BucketKeyEnabled,
+		CacheControl:              input.CacheControl,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		ContentType:               input.ContentType,
+		Key:                       input.Key,
+		Metadata:                  input.Metadata,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		StorageClass:              input.StorageClass,
+		Tagging:                   input.Tagging,
+		WebsiteRedirectLocation:   input.WebsiteRedirectLocation,
+	}
+}
@@ -0,0 +1,259 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// testS3Server is a minimal local stand-in for the S3 object and multipart upload APIs.
+type testS3Server struct {
+	sync.Mutex
+
+	aborted     bool
+	completed   bool
+	failPart    int
+	objects     map[string][]byte
+	parts       map[int][]byte
+	putRequests int
+}
+
+func newTestS3Server() *testS3Server {
+	return &testS3Server{
+		objects: map[string][]byte{},
+		parts:   map[int][]byte{},
+	}
+}
+
+func (s *testS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	query := r.URL.Query()
+	_, uploads := query["uploads"]
+	uploadID := query.Get("uploadId")
+
+	switch {
+	case r.Method == http.MethodPost && uploads:
+		fmt.Fprint(w, `<InitiateMultipartUploadResult><UploadId>test-upload</UploadId></InitiateMultipartUploadResult>`)
+
+	case r.Method == http.MethodPut && uploadID != "":
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+
+		if partNumber == s.failPart {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `<Error><Code>InvalidPart</Code><Message>injected failure</Message></Error>`)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		s.parts[partNumber] = body
+		sum := md5.Sum(body)
+		w.Header().Set("ETag", fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:])))
+
+	case r.Method == http.MethodPost && uploadID != "":
+		var object, digests []byte
+		var partNumbers []int
+
+		for partNumber := range s.parts {
+			partNumbers = append(partNumbers, partNumber)
+		}
+
+		sort.Ints(partNumbers)
+
+		for _, partNumber := range partNumbers {
+			part := s.parts[partNumber]
+			sum := md5.Sum(part)
+			object = append(object, part...)
+			digests = append(digests, sum[:]...)
+		}
+
+		s.completed = true
+		s.objects[r.URL.Path] = object
+		sum := md5.Sum(digests)
+		fmt.Fprintf(w, `<CompleteMultipartUploadResult><ETag>"%s-%d"</ETag></CompleteMultipartUploadResult>`, hex.EncodeToString(sum[:]), len(partNumbers))
+
+	case r.Method == http.MethodDelete && uploadID != "":
+		s.aborted = true
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		s.putRequests++
+		s.objects[r.URL.Path] = body
+		sum := md5.Sum(body)
+		w.Header().Set("ETag", fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:])))
+
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func testS3Conn(t *testing.T, server *httptest.Server) *s3.S3 {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("test", "test", ""),
+		Endpoint:         aws.String(server.URL),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String("us-east-1"),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return s3.New(sess)
+}
+
+func testMD5Hex(b []byte) string {
+	sum := md5.Sum(b)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func TestUploadBucketObject(t *testing.T) {
+	const partSize = 5 * 1024 * 1024
+
+	body := bytes.Repeat([]byte("0123456789abcdef"), (2*partSize+1024)/16)
+
+	var digests []byte
+	for i := 0; i < len(body); i += partSize {
+		end := i + partSize
+		if end > len(body) {
+			end = len(body)
+		}
+		sum := md5.Sum(body[i:end])
+		digests = append(digests, sum[:]...)
+	}
+	multipartETag := testMD5Hex(digests) + "-3"
+
+	testCases := []struct {
+		Name                  string
+		Body                  []byte
+		FailPart              int
+		Threshold             int64
+		ExpectError           bool
+		ExpectedMultipartETag string
+		ExpectedPutRequests   int
+	}{
+		{
+			Name:                "below threshold",
+			Body:                []byte("hello world"),
+			Threshold:           partSize,
+			ExpectedPutRequests: 1,
+		},
+		{
+			Name:                "empty body",
+			Threshold:           partSize,
+			ExpectedPutRequests: 1,
+		},
+		{
+			Name:                  "above threshold",
+			Body:                  body,
+			Threshold:             partSize,
+			ExpectedMultipartETag: multipartETag,
+		},
+		{
+			Name:        "part failure",
+			Body:        body,
+			FailPart:    2,
+			Threshold:   partSize,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			handler := newTestS3Server()
+			handler.failPart = testCase.FailPart
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			conn := testS3Conn(t, server)
+			input := &s3.PutObjectInput{
+				Body:   bytes.NewReader(testCase.Body),
+				Bucket: aws.String("test-bucket"),
+				Key:    aws.String("test-key"),
+			}
+			options := bucketObjectUploadOptions{
+				Concurrency: 3,
+				PartSize:    partSize,
+				Threshold:   testCase.Threshold,
+			}
+
+			output, err := uploadBucketObject(conn, input, options)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if !handler.aborted {
+					t.Error("expected multipart upload to be aborted")
+				}
+
+				if handler.completed {
+					t.Error("expected multipart upload not to be completed")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := output.ETag, testMD5Hex(testCase.Body); got != want {
+				t.Errorf("ETag = %q, want %q", got, want)
+			}
+
+			if got, want := output.MultipartETag, testCase.ExpectedMultipartETag; got != want {
+				t.Errorf("MultipartETag = %q, want %q", got, want)
+			}
+
+			if got, want := handler.putRequests, testCase.ExpectedPutRequests; got != want {
+				t.Errorf("PutObject requests = %d, want %d", got, want)
+			}
+
+			if got := handler.objects["/test-bucket/test-key"]; !bytes.Equal(got, testCase.Body) {
+				t.Errorf("uploaded object (%d bytes) does not match body (%d bytes)", len(got), len(testCase.Body))
+			}
+		})
+	}
+}
+
+func TestIsMultipartETag(t *testing.T) {
+	testCases := []struct {
+		ETag     string
+		Expected bool
+	}{
+		{
+			ETag:     "5eb63bbbe01eeed093cb22bb8f5acdc3",
+			Expected: false,
+		},
+		{
+			ETag:     "2c9bc41c7ec8b1b0e3ce8d0d4f0cc3ef-3",
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := isMultipartETag(testCase.ETag); got != testCase.Expected {
+			t.Errorf("isMultipartETag(%q) = %t, want %t", testCase.ETag, got, testCase.Expected)
+		}
+	}
+}
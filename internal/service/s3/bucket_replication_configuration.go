@@ -0,0 +1,141 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketReplicationConfigurationCreate,
+		Read:   resourceBucketReplicationConfigurationRead,
+		Update: resourceBucketReplicationConfigurationUpdate,
+		Delete: resourceBucketReplicationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      rulesHash,
+				Elem:     bucketReplicationRuleSchema(),
+			},
+		},
+	}
+}
+
+func resourceBucketReplicationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	if err := resourceBucketReplicationConfigurationPut(conn, bucket, expandBucketReplicationConfiguration(d)); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceBucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.GetBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketReplication(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeReplicationConfigurationNotFound) {
+		log.Printf("[WARN] S3 Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil || output.ReplicationConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Replication Configuration: empty response", d.Id())
+	}
+
+	replicationConfiguration := flattenBucketReplicationConfiguration(output.ReplicationConfiguration)[0]
+
+	d.Set("bucket", d.Id())
+	d.Set("role", replicationConfiguration["role"])
+
+	if err := d.Set("rules", replicationConfiguration["rules"]); err != nil {
+		return fmt.Errorf("error setting rules: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketReplicationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	if err := resourceBucketReplicationConfigurationPut(conn, d.Id(), expandBucketReplicationConfiguration(d)); err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceBucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketReplication(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandBucketReplicationConfiguration returns the replication configuration in the
+// form of an aws_s3_bucket replication_configuration block.
+func expandBucketReplicationConfiguration(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"role":  d.Get("role"),
+		"rules": d.Get("rules"),
+	}
+}
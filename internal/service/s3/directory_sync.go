@@ -0,0 +1,567 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncConcurrencyDefault = 10
+	// DeleteObjects accepts at most 1000 keys per request.
+	directorySyncDeleteBatchSize = 1000
+	// The MD5 of the local file is stored as the x-amz-meta-md5 object metadata,
+	// as the ETag of an object is not its MD5 when it is uploaded in parts or encrypted with SSE-KMS.
+	directorySyncMD5MetadataKey = "md5"
+)
+
+// ResourceDirectorySync manages the objects uploaded from a local directory to a bucket prefix as a single resource.
+// Only the objects it uploaded are tracked, other objects under the prefix are left untouched.
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDirectorySyncCreate,
+		Read:   resourceDirectorySyncRead,
+		Update: resourceDirectorySyncUpdate,
+		Delete: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directorySyncConcurrencyDefault,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"deleted_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validDirectorySyncPattern,
+				},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateMetadataIsLowerCase,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validDirectorySyncPattern,
+						},
+					},
+				},
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"uploaded_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, keyPrefix))
+
+	if err := resourceDirectorySyncPut(d, meta, true); err != nil {
+		return fmt.Errorf("error creating S3 Directory Sync (%s): %w", d.Id(), err)
+	}
+
+	return resourceDirectorySyncRead(d, meta)
+}
+
+func resourceDirectorySyncRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("key_prefix"); ok {
+		input.Prefix = aws.String(v.(string))
+	}
+
+	etags := make(map[string]string)
+
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, object := range page.Contents {
+			etags[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Directory Sync (%s): %w", d.Id(), err)
+	}
+
+	state := d.Get("files").(map[string]interface{})
+	var keys []string
+
+	for key := range state {
+		if _, ok := etags[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+
+	md5s, err := readDirectorySyncObjectMD5s(conn, bucket, keys, d.Get("concurrency").(int))
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Directory Sync (%s): %w", d.Id(), err)
+	}
+
+	// Objects that were removed or changed outside of Terraform are uploaded again on the next apply.
+	files := make(map[string]string)
+
+	for _, key := range keys {
+		if v, ok := md5s[key]; ok {
+			files[key] = v
+			continue
+		}
+
+		// Objects uploaded without the MD5 metadata are compared by ETag, unless it is not the MD5 of the object.
+		etag := etags[key]
+
+		if isMultipartETag(etag) {
+			etag = state[key].(string)
+		}
+
+		files[key] = etag
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("error setting files: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceDirectorySyncPut(d, meta, d.HasChanges("acl", "rule")); err != nil {
+		return fmt.Errorf("error updating S3 Directory Sync (%s): %w", d.Id(), err)
+	}
+
+	return resourceDirectorySyncRead(d, meta)
+}
+
+func resourceDirectorySyncDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var keys []string
+
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync (%s): %d objects", d.Id(), len(keys))
+	err := deleteDirectorySyncObjects(conn, d.Get("bucket").(string), keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Directory Sync (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("exclude") || !d.NewValueKnown("rule") {
+		for _, key := range []string{"deleted_keys", "files", "uploaded_keys"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	files, err := scanDirectorySyncFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	old := aws.StringValueMap(flex.ExpandStringMap(d.Get("files").(map[string]interface{})))
+	uploads, deletes := directorySyncChanges(old, files, d.Id() == "" || d.HasChange("acl") || d.HasChange("rule"))
+
+	if len(uploads) == 0 && len(deletes) == 0 {
+		return nil
+	}
+
+	if err := d.SetNew("files", directorySyncFileHashes(files)); err != nil {
+		return err
+	}
+
+	if err := d.SetNew("uploaded_keys", uploads); err != nil {
+		return err
+	}
+
+	return d.SetNew("deleted_keys", deletes)
+}
+
+// resourceDirectorySyncPut uploads new and changed files and deletes the objects of removed files.
+// If all is true, every file is uploaded.
+func resourceDirectorySyncPut(d *schema.ResourceData, meta interface{}, all bool) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	files, err := scanDirectorySyncFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	// d.Get would return the planned value set during CustomizeDiff.
+	o, _ := d.GetChange("files")
+	old := aws.StringValueMap(flex.ExpandStringMap(o.(map[string]interface{})))
+
+	uploads, deletes := directorySyncChanges(old, files, all)
+
+	if len(uploads) == 0 && len(deletes) == 0 {
+		return nil
+	}
+
+	// Record progress so that a failed apply only retries the remaining changes.
+	state := make(map[string]string, len(old))
+
+	for key, v := range old {
+		state[key] = v
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Directory Sync (%s): %d objects", d.Id(), len(uploads))
+	uploaded, uploadErr := uploadDirectorySyncObjects(conn, bucket, d.Get("acl").(string), files, uploads, d.Get("concurrency").(int))
+
+	for _, key := range uploaded {
+		state[key] = files[key].MD5
+	}
+
+	var deleteErr error
+
+	if uploadErr == nil {
+		log.Printf("[DEBUG] Deleting S3 Directory Sync (%s) removed objects: %d objects", d.Id(), len(deletes))
+		deleteErr = deleteDirectorySyncObjects(conn, bucket, deletes)
+
+		if deleteErr == nil {
+			for _, key := range deletes {
+				delete(state, key)
+			}
+		}
+	}
+
+	if err := d.Set("files", state); err != nil {
+		return fmt.Errorf("error setting files: %w", err)
+	}
+
+	if uploadErr != nil {
+		return uploadErr
+	}
+
+	if deleteErr != nil {
+		return deleteErr
+	}
+
+	if err := d.Set("uploaded_keys", uploads); err != nil {
+		return fmt.Errorf("error setting uploaded_keys: %w", err)
+	}
+
+	if err := d.Set("deleted_keys", deletes); err != nil {
+		return fmt.Errorf("error setting deleted_keys: %w", err)
+	}
+
+	return nil
+}
+
+type directorySyncResourceGetter interface {
+	Get(string) interface{}
+}
+
+func scanDirectorySyncFiles(d directorySyncResourceGetter) (map[string]*directorySyncFile, error) {
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir: %w", err)
+	}
+
+	var exclude []string
+
+	if v, ok := d.Get("exclude").(*schema.Set); ok {
+		exclude = aws.StringValueSlice(flex.ExpandStringSet(v))
+	}
+
+	return scanDirectorySyncSource(sourceDir, d.Get("key_prefix").(string), exclude, expandDirectorySyncRules(d.Get("rule").([]interface{})))
+}
+
+func directorySyncFileHashes(files map[string]*directorySyncFile) map[string]string {
+	hashes := make(map[string]string, len(files))
+
+	for key, file := range files {
+		hashes[key] = file.MD5
+	}
+
+	return hashes
+}
+
+// uploadDirectorySyncObjects uploads the specified files concurrently and returns the keys that were uploaded.
+func uploadDirectorySyncObjects(conn *s3.S3, bucket, acl string, files map[string]*directorySyncFile, keys []string, concurrency int) ([]string, error) {
+	var mu sync.Mutex
+	var uploaded []string
+	var errs *multierror.Error
+	var wg sync.WaitGroup
+
+	ch := make(chan string)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for key := range ch {
+				err := uploadDirectorySyncObject(conn, bucket, acl, key, files[key])
+
+				mu.Lock()
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("error uploading S3 Bucket (%s) Object (%s): %w", bucket, key, err))
+				} else {
+					uploaded = append(uploaded, key)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, key := range keys {
+		ch <- key
+	}
+
+	close(ch)
+	wg.Wait()
+
+	return uploaded, errs.ErrorOrNil()
+}
+
+func uploadDirectorySyncObject(conn *s3.S3, bucket, acl, key string, file *directorySyncFile) error {
+	f, err := os.Open(file.Path)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	input := &s3.PutObjectInput{
+		ACL:         aws.String(acl),
+		Body:        f,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(file.ContentType),
+		Key:         aws.String(key),
+	}
+
+	if file.CacheControl != "" {
+		input.CacheControl = aws.String(file.CacheControl)
+	}
+
+	if file.ContentEncoding != "" {
+		input.ContentEncoding = aws.String(file.ContentEncoding)
+	}
+
+	metadata := make(map[string]string, len(file.Metadata)+1)
+
+	for k, v := range file.Metadata {
+		metadata[k] = v
+	}
+
+	metadata[directorySyncMD5MetadataKey] = file.MD5
+	input.Metadata = aws.StringMap(metadata)
+
+	_, err = uploadBucketObject(conn, input, bucketObjectUploadOptions{
+		Concurrency: bucketObjectMultipartConcurrencyDefault,
+		PartSize:    bucketObjectMultipartPartSizeDefault,
+		Threshold:   bucketObjectMultipartThresholdDefault,
+	})
+
+	return err
+}
+
+// readDirectorySyncObjectMD5s reads the MD5 metadata of the specified objects concurrently.
+// Objects without the metadata are omitted.
+func readDirectorySyncObjectMD5s(conn *s3.S3, bucket string, keys []string, concurrency int) (map[string]string, error) {
+	var mu sync.Mutex
+	md5s := make(map[string]string, len(keys))
+	var errs *multierror.Error
+	var wg sync.WaitGroup
+
+	ch := make(chan string)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for key := range ch {
+				output, err := conn.HeadObject(&s3.HeadObjectInput{
+					Bucket: aws.String(bucket),
+					Key:    aws.String(key),
+				})
+
+				// Deleted since the objects were listed.
+				if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+					continue
+				}
+
+				mu.Lock()
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("error reading S3 Bucket (%s) Object (%s): %w", bucket, key, err))
+				} else if v, ok := directorySyncObjectMD5(output.Metadata); ok {
+					md5s[key] = v
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, key := range keys {
+		ch <- key
+	}
+
+	close(ch)
+	wg.Wait()
+
+	return md5s, errs.ErrorOrNil()
+}
+
+// directorySyncObjectMD5 returns the MD5 metadata of an object.
+// The AWS Go SDK canonicalizes metadata keys like HTTP header names, e.g. "Md5".
+func directorySyncObjectMD5(metadata map[string]*string) (string, bool) {
+	for k, v := range metadata {
+		if strings.EqualFold(k, directorySyncMD5MetadataKey) && v != nil {
+			return aws.StringValue(v), true
+		}
+	}
+
+	return "", false
+}
+
+// deleteDirectorySyncObjects deletes the specified keys in batches.
+func deleteDirectorySyncObjects(conn *s3.S3, bucket string, keys []string) error {
+	var errs *multierror.Error
+
+	for len(keys) > 0 {
+		n := len(keys)
+
+		if n > directorySyncDeleteBatchSize {
+			n = directorySyncDeleteBatchSize
+		}
+
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Quiet: aws.Bool(true),
+			},
+		}
+
+		for _, key := range keys[:n] {
+			input.Delete.Objects = append(input.Delete.Objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		keys = keys[n:]
+
+		output, err := conn.DeleteObjects(input)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("error deleting S3 Bucket (%s) Object (%s): %s: %s", bucket, aws.StringValue(v.Key), aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
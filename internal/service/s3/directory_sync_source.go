@@ -0,0 +1,234 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// directorySyncRule holds the object properties applied to files matching a pattern.
+type directorySyncRule struct {
+	CacheControl    string
+	ContentEncoding string
+	ContentType     string
+	Metadata        map[string]string
+	Pattern         string
+}
+
+// directorySyncFile is a regular file found under the source directory.
+type directorySyncFile struct {
+	CacheControl    string
+	ContentEncoding string
+	ContentType     string
+	MD5             string
+	Metadata        map[string]string
+	Path            string
+}
+
+// directorySyncPatternMatch returns whether the specified path, relative to the source directory and
+// using forward slashes, matches the pattern.
+// Patterns without a '/' are matched against the file name, other patterns against the whole relative path.
+func directorySyncPatternMatch(pattern, relPath string) bool {
+	name := relPath
+
+	if !strings.Contains(pattern, "/") {
+		name = path.Base(relPath)
+	}
+
+	matched, _ := path.Match(pattern, name)
+
+	return matched
+}
+
+func validDirectorySyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, value, err))
+	}
+
+	return
+}
+
+// scanDirectorySyncSource walks the source directory and returns its regular files, keyed by S3 object key.
+// Symbolic links are not followed.
+func scanDirectorySyncSource(sourceDir, keyPrefix string, exclude []string, rules []*directorySyncRule) (map[string]*directorySyncFile, error) {
+	files := make(map[string]*directorySyncFile)
+
+	err := filepath.Walk(sourceDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(sourceDir, filePath)
+
+		if err != nil {
+			return err
+		}
+
+		relPath = filepath.ToSlash(relPath)
+
+		for _, pattern := range exclude {
+			if directorySyncPatternMatch(pattern, relPath) {
+				return nil
+			}
+		}
+
+		file, err := newDirectorySyncFile(filePath, relPath, rules)
+
+		if err != nil {
+			return err
+		}
+
+		files[keyPrefix+relPath] = file
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading source directory (%s): %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+func newDirectorySyncFile(filePath, relPath string, rules []*directorySyncRule) (*directorySyncFile, error) {
+	f, err := os.Open(filePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	file := &directorySyncFile{
+		Metadata: make(map[string]string),
+		Path:     filePath,
+	}
+
+	// Later rules override earlier ones; metadata is merged.
+	for _, rule := range rules {
+		if !directorySyncPatternMatch(rule.Pattern, relPath) {
+			continue
+		}
+
+		if rule.CacheControl != "" {
+			file.CacheControl = rule.CacheControl
+		}
+
+		if rule.ContentEncoding != "" {
+			file.ContentEncoding = rule.ContentEncoding
+		}
+
+		if rule.ContentType != "" {
+			file.ContentType = rule.ContentType
+		}
+
+		for k, v := range rule.Metadata {
+			file.Metadata[k] = v
+		}
+	}
+
+	hash := md5.New()
+
+	if file.ContentType == "" {
+		file.ContentType = mime.TypeByExtension(path.Ext(relPath))
+	}
+
+	if file.ContentType == "" {
+		// Sniff the content type from the first 512 bytes.
+		head := make([]byte, 512)
+		n, err := io.ReadFull(f, head)
+
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+
+		file.ContentType = http.DetectContentType(head[:n])
+		hash.Write(head[:n])
+	}
+
+	if _, err := io.Copy(hash, f); err != nil {
+		return nil, err
+	}
+
+	file.MD5 = hex.EncodeToString(hash.Sum(nil))
+
+	return file, nil
+}
+
+// directorySyncChanges returns the keys to upload and the keys to delete, in order.
+// If all is true, every local file is uploaded regardless of its hash.
+func directorySyncChanges(old map[string]string, files map[string]*directorySyncFile, all bool) ([]string, []string) {
+	var uploads, deletes []string
+
+	for key, file := range files {
+		if v, ok := old[key]; all || !ok || v != file.MD5 {
+			uploads = append(uploads, key)
+		}
+	}
+
+	for key := range old {
+		if _, ok := files[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+
+	sort.Strings(uploads)
+	sort.Strings(deletes)
+
+	return uploads, deletes
+}
+
+func expandDirectorySyncRules(tfList []interface{}) []*directorySyncRule {
+	var rules []*directorySyncRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := &directorySyncRule{}
+
+		if v, ok := tfMap["cache_control"].(string); ok {
+			rule.CacheControl = v
+		}
+
+		if v, ok := tfMap["content_encoding"].(string); ok {
+			rule.ContentEncoding = v
+		}
+
+		if v, ok := tfMap["content_type"].(string); ok {
+			rule.ContentType = v
+		}
+
+		if v, ok := tfMap["metadata"].(map[string]interface{}); ok {
+			rule.Metadata = aws.StringValueMap(flex.ExpandStringMap(v))
+		}
+
+		if v, ok := tfMap["pattern"].(string); ok {
+			rule.Pattern = v
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
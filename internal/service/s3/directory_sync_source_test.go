@@ -0,0 +1,188 @@
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDirectorySyncPatternMatch(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		RelPath  string
+		Expected bool
+	}{
+		{
+			Pattern:  "*.html",
+			RelPath:  "index.html",
+			Expected: true,
+		},
+		{
+			Pattern:  "*.html",
+			RelPath:  "docs/guide/index.html",
+			Expected: true,
+		},
+		{
+			Pattern:  "*.html",
+			RelPath:  "docs/style.css",
+			Expected: false,
+		},
+		{
+			Pattern:  "assets/*",
+			RelPath:  "assets/app.js",
+			Expected: true,
+		},
+		{
+			Pattern:  "assets/*",
+			RelPath:  "assets/img/logo.png",
+			Expected: false,
+		},
+		{
+			Pattern:  "assets/*/*",
+			RelPath:  "assets/img/logo.png",
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := directorySyncPatternMatch(testCase.Pattern, testCase.RelPath); got != testCase.Expected {
+			t.Errorf("directorySyncPatternMatch(%q, %q) = %t, want %t", testCase.Pattern, testCase.RelPath, got, testCase.Expected)
+		}
+	}
+}
+
+func TestScanDirectorySyncSource(t *testing.T) {
+	sourceDir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.html":          "<html></html>",
+		"assets/app.js":       "console.log(1)",
+		"assets/data":         "%PDF-1.4",
+		"assets/README.draft": "draft",
+	} {
+		filePath := filepath.Join(sourceDir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rules := []*directorySyncRule{
+		{
+			CacheControl: "max-age=300",
+			Metadata:     map[string]string{"team": "docs"},
+			Pattern:      "*",
+		},
+		{
+			CacheControl: "max-age=31536000",
+			Metadata:     map[string]string{"immutable": "true"},
+			Pattern:      "assets/*",
+		},
+	}
+
+	files, err := scanDirectorySyncSource(sourceDir, "site/", []string{"*.draft"}, rules)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var keys []string
+
+	for key := range files {
+		keys = append(keys, key)
+	}
+
+	if got, want := len(files), 3; got != want {
+		t.Fatalf("got %d files (%v), want %d", got, keys, want)
+	}
+
+	testCases := []struct {
+		Key          string
+		CacheControl string
+		ContentType  string
+		MD5          string
+		Metadata     map[string]string
+	}{
+		{
+			Key:          "site/index.html",
+			CacheControl: "max-age=300",
+			MD5:          testMD5Hex([]byte("<html></html>")),
+			Metadata:     map[string]string{"team": "docs"},
+		},
+		{
+			Key:          "site/assets/app.js",
+			CacheControl: "max-age=31536000",
+			MD5:          testMD5Hex([]byte("console.log(1)")),
+			Metadata:     map[string]string{"immutable": "true", "team": "docs"},
+		},
+		{
+			Key:          "site/assets/data",
+			CacheControl: "max-age=31536000",
+			ContentType:  "application/pdf",
+			MD5:          testMD5Hex([]byte("%PDF-1.4")),
+			Metadata:     map[string]string{"immutable": "true", "team": "docs"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		file, ok := files[testCase.Key]
+
+		if !ok {
+			t.Errorf("key %q not found in %v", testCase.Key, keys)
+			continue
+		}
+
+		if got, want := file.CacheControl, testCase.CacheControl; got != want {
+			t.Errorf("%s: CacheControl = %q, want %q", testCase.Key, got, want)
+		}
+
+		if got, want := file.MD5, testCase.MD5; got != want {
+			t.Errorf("%s: MD5 = %q, want %q", testCase.Key, got, want)
+		}
+
+		if got, want := file.Metadata, testCase.Metadata; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: Metadata = %v, want %v", testCase.Key, got, want)
+		}
+
+		// Extension-based content types depend on the host's MIME tables, so only sniffed types are checked.
+		if testCase.ContentType != "" {
+			if got, want := file.ContentType, testCase.ContentType; got != want {
+				t.Errorf("%s: ContentType = %q, want %q", testCase.Key, got, want)
+			}
+		}
+	}
+}
+
+func TestDirectorySyncChanges(t *testing.T) {
+	old := map[string]string{
+		"a": "1",
+		"b": "2",
+		"c": "3",
+	}
+	files := map[string]*directorySyncFile{
+		"a": {MD5: "1"},
+		"b": {MD5: "20"},
+		"d": {MD5: "4"},
+	}
+
+	uploads, deletes := directorySyncChanges(old, files, false)
+
+	if got, want := uploads, []string{"b", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("uploads = %v, want %v", got, want)
+	}
+
+	if got, want := deletes, []string{"c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("deletes = %v, want %v", got, want)
+	}
+
+	uploads, _ = directorySyncChanges(old, files, true)
+
+	if got, want := uploads, []string{"a", "b", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("uploads (all) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,272 @@
+package s3_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := t.TempDir()
+
+	testAccDirectorySyncWriteFiles(t, sourceDir, map[string]string{
+		"index.html":    "<html>v1</html>",
+		"assets/app.js": "console.log(1)",
+		"notes.draft":   "draft",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObject(resourceName, "site/index.html", "max-age=300", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObject(resourceName, "site/assets/app.js", "max-age=31536000", "application/javascript"),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "deleted_keys.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "uploaded_keys.#", "2"),
+				),
+			},
+			{
+				Config:   testAccDirectorySyncConfig(rName, sourceDir),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, sourceDir, map[string]string{
+						"index.html": "<html>v2</html>",
+					})
+
+					if err := os.Remove(filepath.Join(sourceDir, "assets", "app.js")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObject(resourceName, "site/index.html", "max-age=300", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, "deleted_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deleted_keys.0", "site/assets/app.js"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "uploaded_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "uploaded_keys.0", "site/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := t.TempDir()
+
+	testAccDirectorySyncWriteFiles(t, sourceDir, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceDirectorySync(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_drift(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := t.TempDir()
+
+	testAccDirectorySyncWriteFiles(t, sourceDir, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncDeleteObject(resourceName, "site/index.html"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObject(resourceName, "site/index.html", "max-age=300", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "uploaded_keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, sourceDir string, files map[string]string) {
+	for name, content := range files {
+		filePath := filepath.Join(sourceDir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckDirectorySyncDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory_sync" {
+			continue
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "files.") || k == "files.%" {
+				continue
+			}
+
+			key := strings.TrimPrefix(k, "files.")
+
+			_, err := conn.HeadObject(&s3.HeadObjectInput{
+				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+				Key:    aws.String(key),
+			})
+
+			if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) || tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Directory Sync (%s) object %s (%s) still exists", rs.Primary.ID, key, v)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectorySyncObject(n, key, cacheControl, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Directory Sync ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Directory Sync (%s) object (%s): %w", rs.Primary.ID, key, err)
+		}
+
+		if got := aws.StringValue(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Directory Sync (%s) object (%s) cache control = %q, want %q", rs.Primary.ID, key, got, cacheControl)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Directory Sync (%s) object (%s) content type = %q, want %q", rs.Primary.ID, key, got, contentType)
+		}
+
+		if got, want := aws.StringValue(output.Metadata["Md5"]), rs.Primary.Attributes["files."+key]; got != want {
+			return fmt.Errorf("S3 Directory Sync (%s) object (%s) MD5 metadata = %q, want %q", rs.Primary.ID, key, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncDeleteObject(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		_, err := conn.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccDirectorySyncConfig(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+  exclude    = ["*.draft"]
+
+  rule {
+    pattern       = "*"
+    cache_control = "max-age=300"
+  }
+
+  rule {
+    pattern       = "assets/*"
+    cache_control = "max-age=31536000"
+    content_type  = "application/javascript"
+
+    metadata = {
+      immutable = "true"
+    }
+  }
+}
+`, rName, sourceDir)
+}
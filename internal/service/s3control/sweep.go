@@ -30,7 +30,7 @@ func sweepAccessPoints(region string) error {
 	}
 
 	accountId := client.(*conns.AWSClient).AccountID
-	conn := client.(*conns.AWSClient).S3ControlConn
+	conn := client.(*conns.AWSClient).S3ControlConn()
 
 	input := &s3control.ListAccessPointsInput{
 		AccountId: aws.String(accountId),
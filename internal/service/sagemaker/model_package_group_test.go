@@ -140,7 +140,7 @@ func TestAccSageMakerModelPackageGroup_disappears(t *testing.T) {
 }
 
 func testAccCheckModelPackageGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_model_package_group" {
@@ -176,7 +176,7 @@ func testAccCheckModelPackageGroupExists(n string, mpg *sagemaker.DescribeModelP
 			return fmt.Errorf("No sagmaker Model Package Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 		resp, err := tfsagemaker.FindModelPackageGroupByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -0,0 +1,721 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfigurationSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfigurationSetCreate,
+		Read:   resourceConfigurationSetRead,
+		Update: resourceConfigurationSetUpdate,
+		Delete: resourceConfigurationSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"delivery_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tls_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(sesv2.TlsPolicy_Values(), false),
+						},
+					},
+				},
+			},
+			"reputation_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_fresh_start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reputation_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"sending_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"suppression_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suppressed_reasons": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(sesv2.SuppressionListReason_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tracking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_redirect_domain": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"vdm_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dashboard_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"engagement_metrics": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"guardian_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"optimized_shared_delivery": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceConfigurationSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("configuration_set_name").(string)
+	input := &sesv2.CreateConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeliveryOptions = expandDeliveryOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("reputation_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ReputationOptions = expandReputationOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sending_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SendingOptions = expandSendingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("suppression_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SuppressionOptions = expandSuppressionOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrackingOptions = expandTrackingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vdm_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VdmOptions = expandVDMOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Configuration Set: %s", input)
+	_, err := conn.CreateConfigurationSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Configuration Set (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceConfigurationSetRead(d, meta)
+}
+
+func resourceConfigurationSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindConfigurationSetByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Configuration Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Configuration Set (%s): %w", d.Id(), err)
+	}
+
+	arn := configurationSetARN(meta.(*conns.AWSClient), d.Id())
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", output.ConfigurationSetName)
+
+	if output.DeliveryOptions != nil {
+		if err := d.Set("delivery_options", []interface{}{flattenDeliveryOptions(output.DeliveryOptions)}); err != nil {
+			return fmt.Errorf("error setting delivery_options: %w", err)
+		}
+	} else {
+		d.Set("delivery_options", nil)
+	}
+
+	if output.ReputationOptions != nil {
+		if err := d.Set("reputation_options", []interface{}{flattenReputationOptions(output.ReputationOptions)}); err != nil {
+			return fmt.Errorf("error setting reputation_options: %w", err)
+		}
+	} else {
+		d.Set("reputation_options", nil)
+	}
+
+	if output.SendingOptions != nil {
+		if err := d.Set("sending_options", []interface{}{flattenSendingOptions(output.SendingOptions)}); err != nil {
+			return fmt.Errorf("error setting sending_options: %w", err)
+		}
+	} else {
+		d.Set("sending_options", nil)
+	}
+
+	if output.SuppressionOptions != nil {
+		if err := d.Set("suppression_options", []interface{}{flattenSuppressionOptions(output.SuppressionOptions)}); err != nil {
+			return fmt.Errorf("error setting suppression_options: %w", err)
+		}
+	} else {
+		d.Set("suppression_options", nil)
+	}
+
+	if output.TrackingOptions != nil {
+		if err := d.Set("tracking_options", []interface{}{flattenTrackingOptions(output.TrackingOptions)}); err != nil {
+			return fmt.Errorf("error setting tracking_options: %w", err)
+		}
+	} else {
+		d.Set("tracking_options", nil)
+	}
+
+	if output.VdmOptions != nil {
+		if err := d.Set("vdm_options", []interface{}{flattenVDMOptions(output.VdmOptions)}); err != nil {
+			return fmt.Errorf("error setting vdm_options: %w", err)
+		}
+	} else {
+		d.Set("vdm_options", nil)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SESv2 Configuration Set (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	if d.HasChange("delivery_options") {
+		input := &sesv2.PutConfigurationSetDeliveryOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["sending_pool_name"].(string); ok && v != "" {
+				input.SendingPoolName = aws.String(v)
+			}
+
+			if v, ok := tfMap["tls_policy"].(string); ok && v != "" {
+				input.TlsPolicy = aws.String(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set delivery options: %s", input)
+		_, err := conn.PutConfigurationSetDeliveryOptions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) delivery options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("reputation_options") {
+		input := &sesv2.PutConfigurationSetReputationOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("reputation_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["reputation_metrics_enabled"].(bool); ok {
+				input.ReputationMetricsEnabled = aws.Bool(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set reputation options: %s", input)
+		_, err := conn.PutConfigurationSetReputationOptions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) reputation options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("sending_options") {
+		input := &sesv2.PutConfigurationSetSendingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			SendingEnabled:       aws.Bool(true),
+		}
+
+		if v, ok := d.GetOk("sending_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["sending_enabled"].(bool); ok {
+				input.SendingEnabled = aws.Bool(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set sending options: %s", input)
+		_, err := conn.PutConfigurationSetSendingOptions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) sending options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("suppression_options") {
+		input := &sesv2.PutConfigurationSetSuppressionOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("suppression_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			// An empty list disables suppression; omitting it falls back to the account-level settings.
+			input.SuppressedReasons = []*string{}
+
+			if v, ok := tfMap["suppressed_reasons"].(*schema.Set); ok && v.Len() > 0 {
+				input.SuppressedReasons = flex.ExpandStringSet(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set suppression options: %s", input)
+		_, err := conn.PutConfigurationSetSuppressionOptions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) suppression options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tracking_options") {
+		input := &sesv2.PutConfigurationSetTrackingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["custom_redirect_domain"].(string); ok && v != "" {
+				input.CustomRedirectDomain = aws.String(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set tracking options: %s", input)
+		_, err := conn.PutConfigurationSetTrackingOptions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) tracking options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("vdm_options") {
+		input := &sesv2.PutConfigurationSetVdmOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("vdm_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.VdmOptions = expandVDMOptions(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set VDM options: %s", input)
+		_, err := conn.PutConfigurationSetVdmOptions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) VDM options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceConfigurationSetRead(d, meta)
+}
+
+func resourceConfigurationSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	log.Printf("[DEBUG] Deleting SESv2 Configuration Set: %s", d.Id())
+	_, err := conn.DeleteConfigurationSet(&sesv2.DeleteConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Configuration Set (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func configurationSetARN(client *conns.AWSClient, name string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "ses",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("configuration-set/%s", name),
+	}.String()
+}
+
+func expandDeliveryOptions(tfMap map[string]interface{}) *sesv2.DeliveryOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.DeliveryOptions{}
+
+	if v, ok := tfMap["sending_pool_name"].(string); ok && v != "" {
+		apiObject.SendingPoolName = aws.String(v)
+	}
+
+	if v, ok := tfMap["tls_policy"].(string); ok && v != "" {
+		apiObject.TlsPolicy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandReputationOptions(tfMap map[string]interface{}) *sesv2.ReputationOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.ReputationOptions{}
+
+	if v, ok := tfMap["reputation_metrics_enabled"].(bool); ok {
+		apiObject.ReputationMetricsEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandSendingOptions(tfMap map[string]interface{}) *sesv2.SendingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.SendingOptions{}
+
+	if v, ok := tfMap["sending_enabled"].(bool); ok {
+		apiObject.SendingEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandSuppressionOptions(tfMap map[string]interface{}) *sesv2.SuppressionOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.SuppressionOptions{
+		SuppressedReasons: []*string{},
+	}
+
+	if v, ok := tfMap["suppressed_reasons"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SuppressedReasons = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandTrackingOptions(tfMap map[string]interface{}) *sesv2.TrackingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.TrackingOptions{}
+
+	if v, ok := tfMap["custom_redirect_domain"].(string); ok && v != "" {
+		apiObject.CustomRedirectDomain = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandVDMOptions(tfMap map[string]interface{}) *sesv2.VdmOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.VdmOptions{}
+
+	if v, ok := tfMap["dashboard_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DashboardOptions = expandDashboardOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["guardian_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GuardianOptions = expandGuardianOptions(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDashboardOptions(tfMap map[string]interface{}) *sesv2.DashboardOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.DashboardOptions{}
+
+	if v, ok := tfMap["engagement_metrics"].(string); ok && v != "" {
+		apiObject.EngagementMetrics = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGuardianOptions(tfMap map[string]interface{}) *sesv2.GuardianOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.GuardianOptions{}
+
+	if v, ok := tfMap["optimized_shared_delivery"].(string); ok && v != "" {
+		apiObject.OptimizedSharedDelivery = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDeliveryOptions(apiObject *sesv2.DeliveryOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SendingPoolName; v != nil {
+		tfMap["sending_pool_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TlsPolicy; v != nil {
+		tfMap["tls_policy"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenReputationOptions(apiObject *sesv2.ReputationOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"reputation_metrics_enabled": aws.BoolValue(apiObject.ReputationMetricsEnabled),
+	}
+
+	if v := apiObject.LastFreshStart; v != nil {
+		tfMap["last_fresh_start"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenSendingOptions(apiObject *sesv2.SendingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"sending_enabled": aws.BoolValue(apiObject.SendingEnabled),
+	}
+
+	return tfMap
+}
+
+func flattenSuppressionOptions(apiObject *sesv2.SuppressionOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"suppressed_reasons": flex.FlattenStringSet(apiObject.SuppressedReasons),
+	}
+
+	return tfMap
+}
+
+func flattenTrackingOptions(apiObject *sesv2.TrackingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomRedirectDomain; v != nil {
+		tfMap["custom_redirect_domain"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenVDMOptions(apiObject *sesv2.VdmOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DashboardOptions; v != nil {
+		tfMap["dashboard_options"] = []interface{}{flattenDashboardOptions(v)}
+	}
+
+	if v := apiObject.GuardianOptions; v != nil {
+		tfMap["guardian_options"] = []interface{}{flattenGuardianOptions(v)}
+	}
+
+	return tfMap
+}
+
+func flattenDashboardOptions(apiObject *sesv2.DashboardOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EngagementMetrics; v != nil {
+		tfMap["engagement_metrics"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenGuardianOptions(apiObject *sesv2.GuardianOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OptimizedSharedDelivery; v != nil {
+		tfMap["optimized_shared_delivery"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,292 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceContactList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContactListCreate,
+		Read:   resourceContactListRead,
+		Update: resourceContactListUpdate,
+		Delete: resourceContactListDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_list_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"topic": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_subscription_status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(sesv2.SubscriptionStatus_Values(), false),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"topic_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceContactListCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("contact_list_name").(string)
+	input := &sesv2.CreateContactListInput{
+		ContactListName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("topic"); ok && v.(*schema.Set).Len() > 0 {
+		input.Topics = expandTopics(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Contact List: %s", input)
+	_, err := conn.CreateContactList(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Contact List (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceContactListRead(d, meta)
+}
+
+func resourceContactListRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindContactListByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Contact List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Contact List (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "ses",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("contact-list/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("contact_list_name", output.ContactListName)
+	if output.CreatedTimestamp != nil {
+		d.Set("created_timestamp", aws.TimeValue(output.CreatedTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("created_timestamp", nil)
+	}
+	d.Set("description", output.Description)
+	if output.LastUpdatedTimestamp != nil {
+		d.Set("last_updated_timestamp", aws.TimeValue(output.LastUpdatedTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_timestamp", nil)
+	}
+
+	if err := d.Set("topic", flattenTopics(output.Topics)); err != nil {
+		return fmt.Errorf("error setting topic: %w", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SESv2 Contact List (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceContactListUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	if d.HasChanges("description", "topic") {
+		input := &sesv2.UpdateContactListInput{
+			ContactListName: aws.String(d.Id()),
+			Topics:          expandTopics(d.Get("topic").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Contact List: %s", input)
+		_, err := conn.UpdateContactList(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Contact List (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SESv2 Contact List (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceContactListRead(d, meta)
+}
+
+func resourceContactListDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	log.Printf("[DEBUG] Deleting SESv2 Contact List: %s", d.Id())
+	_, err := conn.DeleteContactList(&sesv2.DeleteContactListInput{
+		ContactListName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Contact List (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandTopics(tfList []interface{}) []*sesv2.Topic {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*sesv2.Topic
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &sesv2.Topic{}
+
+		if v, ok := tfMap["default_subscription_status"].(string); ok && v != "" {
+			apiObject.DefaultSubscriptionStatus = aws.String(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["display_name"].(string); ok && v != "" {
+			apiObject.DisplayName = aws.String(v)
+		}
+
+		if v, ok := tfMap["topic_name"].(string); ok && v != "" {
+			apiObject.TopicName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTopics(apiObjects []*sesv2.Topic) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"default_subscription_status": aws.StringValue(apiObject.DefaultSubscriptionStatus),
+			"description":                 aws.StringValue(apiObject.Description),
+			"display_name":                aws.StringValue(apiObject.DisplayName),
+			"topic_name":                  aws.StringValue(apiObject.TopicName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,102 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEmailIdentityFeedbackAttributes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailIdentityFeedbackAttributesPut,
+		Read:   resourceEmailIdentityFeedbackAttributesRead,
+		Update: resourceEmailIdentityFeedbackAttributesPut,
+		Delete: resourceEmailIdentityFeedbackAttributesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email_forwarding_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"email_identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceEmailIdentityFeedbackAttributesPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	name := d.Get("email_identity").(string)
+	input := &sesv2.PutEmailIdentityFeedbackAttributesInput{
+		EmailForwardingEnabled: aws.Bool(d.Get("email_forwarding_enabled").(bool)),
+		EmailIdentity:          aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Putting SESv2 Email Identity Feedback Attributes: %s", input)
+	_, err := conn.PutEmailIdentityFeedbackAttributes(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting SESv2 Email Identity Feedback Attributes (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEmailIdentityFeedbackAttributesRead(d, meta)
+}
+
+func resourceEmailIdentityFeedbackAttributesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	output, err := FindEmailIdentityByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Email Identity Feedback Attributes (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Email Identity Feedback Attributes (%s): %w", d.Id(), err)
+	}
+
+	d.Set("email_forwarding_enabled", output.FeedbackForwardingStatus)
+	d.Set("email_identity", d.Id())
+
+	return nil
+}
+
+func resourceEmailIdentityFeedbackAttributesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	// There is no API to remove feedback attributes, re-enable the service default of forwarding feedback by email.
+	log.Printf("[DEBUG] Deleting SESv2 Email Identity Feedback Attributes: %s", d.Id())
+	_, err := conn.PutEmailIdentityFeedbackAttributes(&sesv2.PutEmailIdentityFeedbackAttributesInput{
+		EmailForwardingEnabled: aws.Bool(true),
+		EmailIdentity:          aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Email Identity Feedback Attributes (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+)
+
+func TestAccSESV2EmailIdentityFeedbackAttributes_basic(t *testing.T) {
+	rName := acctest.RandomEmailAddress(acctest.RandomDomainName())
+	resourceName := "aws_sesv2_email_identity_feedback_attributes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityFeedbackAttributesConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityFeedbackAttributesExists(resourceName, false),
+					resource.TestCheckResourceAttrPair(resourceName, "email_identity", "aws_sesv2_email_identity.test", "email_identity"),
+					resource.TestCheckResourceAttr(resourceName, "email_forwarding_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailIdentityFeedbackAttributesConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityFeedbackAttributesExists(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "email_forwarding_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentityFeedbackAttributes_disappears_emailIdentity(t *testing.T) {
+	rName := acctest.RandomEmailAddress(acctest.RandomDomainName())
+	resourceName := "aws_sesv2_email_identity_feedback_attributes.test"
+	emailIdentityResourceName := "aws_sesv2_email_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityFeedbackAttributesConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityFeedbackAttributesExists(resourceName, false),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsesv2.ResourceEmailIdentity(), emailIdentityResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEmailIdentityFeedbackAttributesExists(n string, emailForwardingEnabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Email Identity Feedback Attributes ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn()
+
+		output, err := tfsesv2.FindEmailIdentityByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.BoolValue(output.FeedbackForwardingStatus); got != emailForwardingEnabled {
+			return fmt.Errorf("SESv2 Email Identity (%s) feedback forwarding status = %t, want %t", rs.Primary.ID, got, emailForwardingEnabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccEmailIdentityFeedbackAttributesConfig(rName string, emailForwardingEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+}
+
+resource "aws_sesv2_email_identity_feedback_attributes" "test" {
+  email_identity           = aws_sesv2_email_identity.test.email_identity
+  email_forwarding_enabled = %[2]t
+}
+`, rName, emailForwardingEnabled)
+}
@@ -0,0 +1,25 @@
+package sesv2
+
+import (
+	"fmt"
+	"strings"
+)
+
+const configurationSetEventDestinationResourceIDSeparator = "|"
+
+func ConfigurationSetEventDestinationCreateResourceID(configurationSetName, eventDestinationName string) string {
+	parts := []string{configurationSetName, eventDestinationName}
+	id := strings.Join(parts, configurationSetEventDestinationResourceIDSeparator)
+
+	return id
+}
+
+func ConfigurationSetEventDestinationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configurationSetEventDestinationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONFIGURATIONSETNAME%[2]sEVENTDESTINATIONNAME", id, configurationSetEventDestinationResourceIDSeparator)
+}
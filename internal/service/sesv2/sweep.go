@@ -0,0 +1,122 @@
+//go:build sweep
+// +build sweep
+
+package sesv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+// Configuration sets and email identities are shared with the SES v1 API and are swept by the aws_ses_* sweepers.
+func init() {
+	resource.AddTestSweepers("aws_sesv2_contact_list", &resource.Sweeper{
+		Name: "aws_sesv2_contact_list",
+		F:    sweepContactLists,
+	})
+
+	resource.AddTestSweepers("aws_sesv2_dedicated_ip_pool", &resource.Sweeper{
+		Name: "aws_sesv2_dedicated_ip_pool",
+		F:    sweepDedicatedIPPools,
+		Dependencies: []string{
+			"aws_ses_configuration_set",
+		},
+	})
+}
+
+func sweepContactLists(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SESV2Conn()
+	input := &sesv2.ListContactListsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListContactListsPages(input, func(page *sesv2.ListContactListsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ContactLists {
+			r := ResourceContactList()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ContactListName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SESv2 Contact Lists sweep for %s: %s", region, err)
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SESv2 Contact Lists (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SESv2 Contact Lists (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDedicatedIPPools(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SESV2Conn()
+	input := &sesv2.ListDedicatedIpPoolsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListDedicatedIpPoolsPages(input, func(page *sesv2.ListDedicatedIpPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DedicatedIpPools {
+			r := ResourceDedicatedIPPool()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SESv2 Dedicated IP Pools sweep for %s: %s", region, err)
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SESv2 Dedicated IP Pools (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SESv2 Dedicated IP Pools (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
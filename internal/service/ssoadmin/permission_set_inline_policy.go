@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,8 @@ func ResourcePermissionSetInlinePolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: verify.ValidatePolicyDiff(accessanalyzer.PolicyTypeIdentityPolicy, "inline_policy"),
+
 		Schema: map[string]*schema.Schema{
 			"inline_policy": {
 				Type:             schema.TypeString,
@@ -47,7 +50,7 @@ func ResourcePermissionSetInlinePolicy() *schema.Resource {
 }
 
 func resourcePermissionSetInlinePolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSOAdminConn
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
 
 	instanceArn := d.Get("instance_arn").(string)
 	permissionSetArn := d.Get("permission_set_arn").(string)
@@ -74,7 +77,7 @@ func resourcePermissionSetInlinePolicyPut(d *schema.ResourceData, meta interface
 }
 
 func resourcePermissionSetInlinePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSOAdminConn
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
 
 	permissionSetArn, instanceArn, err := ParseResourceID(d.Id())
 	if err != nil {
@@ -110,7 +113,7 @@ func resourcePermissionSetInlinePolicyRead(d *schema.ResourceData, meta interfac
 }
 
 func resourcePermissionSetInlinePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSOAdminConn
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
 
 	permissionSetArn, instanceArn, err := ParseResourceID(d.Id())
 	if err != nil {
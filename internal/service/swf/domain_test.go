@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/swf"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,6 +15,11 @@ import (
 )
 
 func testAccPreCheckSwfDomainTestingEnabled(t *testing.T) {
+	// Replayed tests do not register domains.
+	if acctest.RecordMode() == acctest.RecordModeReplay {
+		return
+	}
+
 	if os.Getenv("SWF_DOMAIN_TESTING_ENABLED") == "" {
 		t.Skip(
 			"Environment variable SWF_DOMAIN_TESTING_ENABLED is not set. " +
@@ -25,7 +29,7 @@ func testAccPreCheckSwfDomainTestingEnabled(t *testing.T) {
 }
 
 func TestAccSWFDomain_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_swf_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +60,7 @@ func TestAccSWFDomain_basic(t *testing.T) {
 }
 
 func TestAccSWFDomain_tags(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_swf_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -159,7 +163,7 @@ func TestAccSWFDomain_generatedName(t *testing.T) {
 }
 
 func TestAccSWFDomain_description(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_swf_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -188,7 +192,7 @@ func TestAccSWFDomain_description(t *testing.T) {
 }
 
 func testAccCheckDomainDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_swf_domain" {
@@ -225,7 +229,7 @@ func testAccCheckDomainExists(n string) resource.TestCheckFunc {
 		}
 
 		name := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn()
 
 		input := &swf.DescribeDomainInput{
 			Name: aws.String(name),
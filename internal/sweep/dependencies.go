@@ -0,0 +1,164 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var (
+	sweepDependencies     = make(map[string][]string)
+	sweepDependenciesLock sync.RWMutex
+
+	sweepers = make(map[string]*resource.Sweeper)
+)
+
+// AddSweepDependencies registers the resource types whose resources must be deleted
+// before resources of the specified type, e.g. network interfaces before the subnets they are attached to.
+// The dependencies order resources swept together by the orchestrator and are added to the
+// Dependencies of the type's sweeper registered with AddTestSweepers, so that they also order
+// separate sweepers, regardless of which of the two is registered first.
+func AddSweepDependencies(typeName string, dependencies ...string) {
+	sweepDependenciesLock.Lock()
+	defer sweepDependenciesLock.Unlock()
+
+	sweepDependencies[typeName] = append(sweepDependencies[typeName], dependencies...)
+
+	if sweeper, ok := sweepers[typeName]; ok {
+		sweeper.Dependencies = appendSweeperDependencies(sweeper.Dependencies, dependencies...)
+	}
+}
+
+// AddTestSweepers registers a sweeper with the Terraform Plugin SDK, adding the
+// dependencies registered for its resource type with AddSweepDependencies.
+func AddTestSweepers(name string, sweeper *resource.Sweeper) {
+	sweepDependenciesLock.Lock()
+	defer sweepDependenciesLock.Unlock()
+
+	sweeper.Dependencies = appendSweeperDependencies(sweeper.Dependencies, sweepDependencies[name]...)
+	sweepers[name] = sweeper
+
+	resource.AddTestSweepers(name, sweeper)
+}
+
+// SweeperDependencies returns the Dependencies of a sweeper registered with AddTestSweepers.
+func SweeperDependencies(name string) []string {
+	sweepDependenciesLock.RLock()
+	defer sweepDependenciesLock.RUnlock()
+
+	sweeper, ok := sweepers[name]
+
+	if !ok {
+		return nil
+	}
+
+	return append([]string(nil), sweeper.Dependencies...)
+}
+
+func appendSweeperDependencies(dependencies []string, add ...string) []string {
+	for _, v := range add {
+		found := false
+
+		for _, dependency := range dependencies {
+			if dependency == v {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			dependencies = append(dependencies, v)
+		}
+	}
+
+	return dependencies
+}
+
+// sweepPhases groups resources into phases so that every resource is swept
+// after the resources of the types it depends on.
+// Resources without a type are swept in the first phase.
+func sweepPhases(sweepResources []*SweepResource) ([][]*SweepResource, error) {
+	sweepDependenciesLock.RLock()
+	defer sweepDependenciesLock.RUnlock()
+
+	present := make(map[string]bool)
+
+	for _, sweepResource := range sweepResources {
+		present[sweepResource.typeName] = true
+	}
+
+	phaseByType := make(map[string]int)
+	visiting := make(map[string]bool)
+
+	var phaseOf func(typeName string, path []string) (int, error)
+	phaseOf = func(typeName string, path []string) (int, error) {
+		if phase, ok := phaseByType[typeName]; ok {
+			return phase, nil
+		}
+
+		if visiting[typeName] {
+			return 0, fmt.Errorf("sweep dependency cycle: %s", strings.Join(append(path, typeName), " -> "))
+		}
+
+		visiting[typeName] = true
+		phase := 0
+
+		for _, dependency := range sweepDependencies[typeName] {
+			if !present[dependency] || dependency == typeName {
+				continue
+			}
+
+			dependencyPhase, err := phaseOf(dependency, append(path, typeName))
+
+			if err != nil {
+				return 0, err
+			}
+
+			if dependencyPhase+1 > phase {
+				phase = dependencyPhase + 1
+			}
+		}
+
+		visiting[typeName] = false
+		phaseByType[typeName] = phase
+
+		return phase, nil
+	}
+
+	typeNames := make([]string, 0, len(present))
+
+	for typeName := range present {
+		typeNames = append(typeNames, typeName)
+	}
+
+	sort.Strings(typeNames)
+
+	phaseCount := 0
+
+	for _, typeName := range typeNames {
+		phase, err := phaseOf(typeName, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if phase+1 > phaseCount {
+			phaseCount = phase + 1
+		}
+	}
+
+	phases := make([][]*SweepResource, phaseCount)
+
+	for _, sweepResource := range sweepResources {
+		phase := phaseByType[sweepResource.typeName]
+		phases[phase] = append(phases[phase], sweepResource)
+	}
+
+	return phases, nil
+}
@@ -0,0 +1,157 @@
+//go:build sweep
+// +build sweep
+
+package sweep_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+// sweeperRunOrder returns the order in which the Terraform Plugin SDK runs the sweeper and its dependencies
+// registered with sweep.AddTestSweepers.
+func sweeperRunOrder(name string, visited map[string]bool) []string {
+	if visited[name] {
+		return nil
+	}
+
+	visited[name] = true
+
+	var order []string
+
+	for _, dependency := range sweep.SweeperDependencies(name) {
+		order = append(order, sweeperRunOrder(dependency, visited)...)
+	}
+
+	return append(order, name)
+}
+
+func TestSweeperDependenciesEC2(t *testing.T) {
+	order := sweeperRunOrder("aws_vpc", make(map[string]bool))
+	position := make(map[string]int)
+
+	for i, name := range order {
+		position[name] = i
+	}
+
+	for _, name := range []string{"aws_network_interface", "aws_subnet", "aws_vpc"} {
+		if _, ok := position[name]; !ok {
+			t.Fatalf("sweeper %s not run by aws_vpc sweeper (%v)", name, order)
+		}
+	}
+
+	if position["aws_network_interface"] > position["aws_subnet"] || position["aws_subnet"] > position["aws_vpc"] {
+		t.Errorf("got sweeper run order %v, expected aws_network_interface, aws_subnet, aws_vpc", order)
+	}
+}
+
+func TestSweepOrchestratorPhasesEC2(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "report.json")
+
+	t.Setenv(conns.EnvVarSweepDryRun, "true")
+	t.Setenv(conns.EnvVarSweepReportFile, filename)
+
+	resources := []struct {
+		ID       string
+		TypeName string
+		Resource func() *schema.Resource
+	}{
+		{ID: "vpc-12345678", TypeName: "aws_vpc", Resource: tfec2.ResourceVPC},
+		{ID: "subnet-12345678", TypeName: "aws_subnet", Resource: tfec2.ResourceSubnet},
+		{ID: "eni-12345678", TypeName: "aws_network_interface", Resource: tfec2.ResourceNetworkInterface},
+	}
+
+	var sweepResources []*sweep.SweepResource
+
+	for _, v := range resources {
+		r := v.Resource()
+		d := r.Data(nil)
+		d.SetId(v.ID)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResourceWithOptions(r, d, nil, sweep.SweepResourceOptions{
+			TypeName: v.TypeName,
+		}))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		t.Fatalf("error reading sweep report: %s", err)
+	}
+
+	var report sweep.Report
+
+	if err := json.Unmarshal(b, &report); err != nil {
+		t.Fatalf("error decoding sweep report: %s", err)
+	}
+
+	phases := make(map[string]int)
+
+	for _, entry := range report.Entries {
+		phases[entry.ID] = entry.Phase
+	}
+
+	expected := map[string]int{
+		"eni-12345678":    0,
+		"subnet-12345678": 1,
+		"vpc-12345678":    2,
+	}
+
+	for id, phase := range expected {
+		if got, ok := phases[id]; !ok || got != phase {
+			t.Errorf("%s: got phase %d (reported: %t), expected %d", id, got, ok, phase)
+		}
+	}
+}
+
+func TestSweepResourceTypeNameEC2(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "report.json")
+
+	t.Setenv(conns.EnvVarSweepDryRun, "true")
+	t.Setenv(conns.EnvVarSweepReportFile, filename)
+
+	r := tfec2.ResourceSubnet()
+	d := r.Data(nil)
+	d.SetId("subnet-87654321")
+
+	if err := sweep.SweepOrchestrator([]*sweep.SweepResource{sweep.NewSweepResource(r, d, nil)}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		t.Fatalf("error reading sweep report: %s", err)
+	}
+
+	var report sweep.Report
+
+	if err := json.Unmarshal(b, &report); err != nil {
+		t.Fatalf("error decoding sweep report: %s", err)
+	}
+
+	for _, entry := range report.Entries {
+		if entry.ID != "subnet-87654321" {
+			continue
+		}
+
+		if got, expected := entry.Type, "aws_subnet"; got != expected {
+			t.Errorf("got type %q, expected %q", got, expected)
+		}
+
+		return
+	}
+
+	t.Errorf("subnet-87654321 not found in sweep report (%v)", report.Entries)
+}
@@ -0,0 +1,173 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestSweepPhases(t *testing.T) {
+	AddSweepDependencies("test_phases_vpc", "test_phases_subnet", "test_phases_eni")
+	AddSweepDependencies("test_phases_subnet", "test_phases_eni")
+
+	testCases := []struct {
+		Name           string
+		TypeNames      []string
+		ExpectedPhases [][]string
+	}{
+		{
+			Name:           "no resources",
+			ExpectedPhases: [][]string{},
+		},
+		{
+			Name:           "no dependencies",
+			TypeNames:      []string{"test_phases_other", ""},
+			ExpectedPhases: [][]string{{"test_phases_other", ""}},
+		},
+		{
+			Name:      "dependencies",
+			TypeNames: []string{"test_phases_vpc", "test_phases_eni", "test_phases_subnet", "test_phases_eni"},
+			ExpectedPhases: [][]string{
+				{"test_phases_eni", "test_phases_eni"},
+				{"test_phases_subnet"},
+				{"test_phases_vpc"},
+			},
+		},
+		{
+			Name:      "absent dependencies",
+			TypeNames: []string{"test_phases_vpc", "test_phases_eni"},
+			ExpectedPhases: [][]string{
+				{"test_phases_eni"},
+				{"test_phases_vpc"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sweepResources := make([]*SweepResource, 0, len(testCase.TypeNames))
+
+			for _, typeName := range testCase.TypeNames {
+				sweepResources = append(sweepResources, &SweepResource{typeName: typeName})
+			}
+
+			phases, err := sweepPhases(sweepResources)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := make([][]string, 0, len(phases))
+
+			for _, phase := range phases {
+				typeNames := make([]string, 0, len(phase))
+
+				for _, sweepResource := range phase {
+					typeNames = append(typeNames, sweepResource.typeName)
+				}
+
+				got = append(got, typeNames)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedPhases) {
+				t.Errorf("got %v, expected %v", got, testCase.ExpectedPhases)
+			}
+		})
+	}
+}
+
+func TestSweepPhasesCycle(t *testing.T) {
+	AddSweepDependencies("test_cycle_a", "test_cycle_b")
+	AddSweepDependencies("test_cycle_b", "test_cycle_a")
+
+	_, err := sweepPhases([]*SweepResource{{typeName: "test_cycle_a"}, {typeName: "test_cycle_b"}})
+
+	if err == nil || !strings.Contains(err.Error(), "sweep dependency cycle") {
+		t.Fatalf("expected dependency cycle error, got: %v", err)
+	}
+}
+
+func TestIsThrottlingError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil error",
+		},
+		{
+			Name: "other error",
+			Err:  errors.New("test"),
+		},
+		{
+			Name:     "AWS throttling error",
+			Err:      awserr.New("ThrottlingException", "Rate exceeded", nil),
+			Expected: true,
+		},
+		{
+			Name:     "wrapped AWS throttling error",
+			Err:      fmt.Errorf("error deleting: %w", awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil)),
+			Expected: true,
+		},
+		{
+			Name: "AWS other error",
+			Err:  awserr.New("InvalidParameterValue", "Throttling is not a parameter", nil),
+		},
+		{
+			Name:     "diagnostic text",
+			Err:      errors.New("error deleting EC2 Subnet (subnet-12345678): Throttling: Rate exceeded"),
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := IsThrottlingError(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConcurrency(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		Expected int
+	}{
+		{
+			Value:    "",
+			Expected: DefaultConcurrency,
+		},
+		{
+			Value:    "3",
+			Expected: 3,
+		},
+		{
+			Value:    "0",
+			Expected: DefaultConcurrency,
+		},
+		{
+			Value:    "invalid",
+			Expected: DefaultConcurrency,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Value, func(t *testing.T) {
+			t.Setenv(conns.EnvVarSweepConcurrency, testCase.Value)
+
+			if got := Concurrency(); got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ErrCodeDryRun is the error code returned for API requests blocked in dry-run mode.
+const ErrCodeDryRun = "SweepDryRun"
+
+const (
+	reasonDefault        = "matched by sweeper"
+	reasonBlockedRequest = "API request that could modify resources blocked in dry-run mode"
+)
+
+// readOnlyOperationPrefixes are the API operation name prefixes allowed in dry-run mode.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Search",
+}
+
+// authenticationOperations are the API operations allowed in dry-run mode so that sweepers can
+// assume the role configured with TF_AWS_ASSUME_ROLE_ARN and validate their credentials.
+var authenticationOperations = []string{
+	"sts:AssumeRole",
+	"sts:AssumeRoleWithWebIdentity",
+	"sts:GetCallerIdentity",
+}
+
+// DryRun returns whether sweepers are running in dry-run mode.
+// In dry-run mode nothing is deleted. Resources that would be deleted are added to the sweep report instead.
+func DryRun() bool {
+	v := os.Getenv(conns.EnvVarSweepDryRun)
+
+	if v == "" {
+		return false
+	}
+
+	dryRun, err := strconv.ParseBool(v)
+
+	if err != nil {
+		// Err on the side of not deleting anything.
+		log.Printf("[WARN] Invalid %s value (%s), running in dry-run mode", conns.EnvVarSweepDryRun, v)
+		return true
+	}
+
+	return dryRun
+}
+
+// ReportEntry describes a resource that would be deleted by a sweeper.
+type ReportEntry struct {
+	// Region is the AWS region of the resource.
+	Region string `json:"region"`
+
+	// Type is the Terraform resource type, if known.
+	Type string `json:"type,omitempty"`
+
+	// ID is the Terraform resource ID.
+	ID string `json:"id,omitempty"`
+
+	// Operation is the blocked API operation, e.g. ec2:DeleteSubnet, for sweepers that call the API directly.
+	Operation string `json:"operation,omitempty"`
+
+	// Parameters are the parameters of the blocked API operation.
+	Parameters string `json:"parameters,omitempty"`
+
+	// Reason describes why the resource would be deleted.
+	Reason string `json:"reason"`
+
+	// Phase is the orchestrator phase in which the resource would be deleted.
+	// Resources in a phase are deleted after all resources in earlier phases.
+	Phase int `json:"phase"`
+}
+
+// Report is the structured report of a dry-run sweep.
+type Report struct {
+	GeneratedAt time.Time     `json:"generated_at"`
+	Entries     []ReportEntry `json:"entries"`
+}
+
+var (
+	report     Report
+	reportLock sync.Mutex
+)
+
+// recordReportEntry adds an entry to the sweep report and writes the report
+// to the file configured by the TF_AWS_SWEEP_REPORT_FILE environment variable.
+// The file is rewritten after every entry as sweepers may exit the test binary at any time.
+func recordReportEntry(entry ReportEntry) {
+	log.Printf("[INFO] Dry run, would sweep: %s", entry)
+
+	reportLock.Lock()
+	defer reportLock.Unlock()
+
+	report.Entries = append(report.Entries, entry)
+	report.GeneratedAt = time.Now().UTC()
+
+	filename := os.Getenv(conns.EnvVarSweepReportFile)
+
+	if filename == "" {
+		return
+	}
+
+	b, err := json.MarshalIndent(report, "", "  ")
+
+	if err != nil {
+		log.Printf("[ERROR] Error encoding sweep report: %s", err)
+		return
+	}
+
+	if err := os.WriteFile(filename, b, 0644); err != nil {
+		log.Printf("[ERROR] Error writing sweep report (%s): %s", filename, err)
+	}
+}
+
+func (entry ReportEntry) String() string {
+	if entry.Operation != "" {
+		return fmt.Sprintf("%s %s (%s)", entry.Region, entry.Operation, entry.Reason)
+	}
+
+	return fmt.Sprintf("%s %s %s (%s)", entry.Region, entry.Type, entry.ID, entry.Reason)
+}
+
+func (sweepResource *SweepResource) reportEntry(phase int) ReportEntry {
+	entry := ReportEntry{
+		ID:     sweepResource.d.Id(),
+		Phase:  phase,
+		Reason: sweepResource.reason,
+		Type:   sweepResource.typeName,
+	}
+
+	if entry.Reason == "" {
+		entry.Reason = reasonDefault
+	}
+
+	if client, ok := sweepResource.meta.(*conns.AWSClient); ok {
+		entry.Region = client.Region
+	}
+
+	return entry
+}
+
+// dryRunHandler blocks API requests that could modify resources, adding them to the sweep report.
+var dryRunHandler = request.NamedHandler{
+	Name: "terraform.sweep.DryRunHandler",
+	Fn: func(r *request.Request) {
+		if r.Error != nil || isReadOnlyOperation(r.ClientInfo.ServiceName, r.Operation.Name) {
+			return
+		}
+
+		recordReportEntry(ReportEntry{
+			Operation:  fmt.Sprintf("%s:%s", r.ClientInfo.ServiceName, r.Operation.Name),
+			Parameters: awsutil.Prettify(r.Params),
+			Reason:     reasonBlockedRequest,
+			Region:     aws.StringValue(r.Config.Region),
+		})
+
+		r.Error = awserr.New(ErrCodeDryRun, fmt.Sprintf("%s request blocked in dry-run mode", r.Operation.Name), nil)
+	},
+}
+
+func isReadOnlyOperation(service, name string) bool {
+	for _, operation := range authenticationOperations {
+		if operation == fmt.Sprintf("%s:%s", service, name) {
+			return true
+		}
+	}
+
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
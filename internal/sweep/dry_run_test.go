@@ -0,0 +1,53 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"testing"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	testCases := []struct {
+		Service   string
+		Operation string
+		Expected  bool
+	}{
+		{
+			Service:   "ec2",
+			Operation: "DescribeSubnets",
+			Expected:  true,
+		},
+		{
+			Service:   "ec2",
+			Operation: "DeleteSubnet",
+		},
+		{
+			Service:   "sts",
+			Operation: "AssumeRole",
+			Expected:  true,
+		},
+		{
+			Service:   "sts",
+			Operation: "AssumeRoleWithWebIdentity",
+			Expected:  true,
+		},
+		{
+			Service:   "sts",
+			Operation: "GetCallerIdentity",
+			Expected:  true,
+		},
+		{
+			Service:   "iam",
+			Operation: "AssumeRole",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Service+":"+testCase.Operation, func(t *testing.T) {
+			if got := isReadOnlyOperation(testCase.Service, testCase.Operation); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	// resourceTypeNames maps the delete function of each registered resource to its type name.
+	// Sweepers create new *schema.Resource instances, so resources are identified by their delete function.
+	// Delete functions shared by several types, e.g. aliases, map to an empty type name.
+	resourceTypeNames     = make(map[uintptr]string)
+	resourceTypeNamesLock sync.RWMutex
+)
+
+// RegisterResourceTypes registers the Terraform resource types of the provider's resources,
+// so that sweep resources get their type without it being passed in SweepResourceOptions.
+func RegisterResourceTypes(resources map[string]*schema.Resource) {
+	resourceTypeNamesLock.Lock()
+	defer resourceTypeNamesLock.Unlock()
+
+	for typeName, r := range resources {
+		key, ok := resourceDeleteFuncKey(r)
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := resourceTypeNames[key]; ok && v != typeName {
+			resourceTypeNames[key] = ""
+			continue
+		}
+
+		resourceTypeNames[key] = typeName
+	}
+}
+
+// resourceTypeName returns the registered Terraform resource type of the resource, if any.
+func resourceTypeName(r *schema.Resource) string {
+	key, ok := resourceDeleteFuncKey(r)
+
+	if !ok {
+		return ""
+	}
+
+	resourceTypeNamesLock.RLock()
+	defer resourceTypeNamesLock.RUnlock()
+
+	return resourceTypeNames[key]
+}
+
+func resourceDeleteFuncKey(r *schema.Resource) (uintptr, bool) {
+	if r == nil {
+		return 0, false
+	}
+
+	var f interface{}
+
+	switch {
+	case r.DeleteContext != nil:
+		f = r.DeleteContext
+	case r.DeleteWithoutTimeout != nil:
+		f = r.DeleteWithoutTimeout
+	case r.Delete != nil:
+		f = r.Delete
+	default:
+		return 0, false
+	}
+
+	return reflect.ValueOf(f).Pointer(), true
+}
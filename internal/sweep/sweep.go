@@ -5,6 +5,7 @@ package sweep
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -12,7 +13,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,6 +33,9 @@ const (
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
+// DefaultConcurrency is the default maximum number of resources deleted at once by the sweep orchestrator.
+const DefaultConcurrency = 10
+
 // SweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
@@ -79,6 +85,12 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 		}
 	}
 
+	if DryRun() {
+		conf.RequestHandlers = func(handlers *request.Handlers) {
+			handlers.Validate.PushBackNamed(dryRunHandler)
+		}
+	}
+
 	// configures a default client for the region, using the above env vars
 	client, err := conf.Client()
 	if err != nil {
@@ -94,6 +106,9 @@ type SweepResource struct {
 	d        *schema.ResourceData
 	meta     interface{}
 	resource *schema.Resource
+
+	reason   string
+	typeName string
 }
 
 func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
@@ -101,25 +116,89 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta in
 		d:        d,
 		meta:     meta,
 		resource: resource,
+		typeName: resourceTypeName(resource),
+	}
+}
+
+// SweepResourceOptions contains optional information about a resource to sweep.
+type SweepResourceOptions struct {
+	// Reason describes why the resource is swept, e.g. the name prefix it matched.
+	// It is included in dry-run reports.
+	Reason string
+
+	// TypeName is the Terraform resource type, e.g. aws_subnet.
+	// It is included in dry-run reports and used to order the resources of different types
+	// passed to the same SweepOrchestrator call (see AddSweepDependencies).
+	// It defaults to the type registered for the resource with RegisterResourceTypes.
+	TypeName string
+}
+
+func NewSweepResourceWithOptions(resource *schema.Resource, d *schema.ResourceData, meta interface{}, options SweepResourceOptions) *SweepResource {
+	sweepResource := NewSweepResource(resource, d, meta)
+	sweepResource.reason = options.Reason
+
+	if options.TypeName != "" {
+		sweepResource.typeName = options.TypeName
 	}
+
+	return sweepResource
 }
 
 func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorContext deletes the specified resources, retrying on throttling errors.
+// Resources of different types are deleted in phases ordered by their registered sweep dependencies,
+// with at most Concurrency() resources deleted at once. Resources without a type are deleted in the first phase.
+// In dry-run mode the resources are added to the sweep report instead of being deleted.
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	phases, err := sweepPhases(sweepResources)
+
+	if err != nil {
+		return err
+	}
+
+	if DryRun() {
+		for i, phase := range phases {
+			for _, sweepResource := range phase {
+				recordReportEntry(sweepResource.reportEntry(i))
+			}
+		}
+
+		return nil
+	}
+
+	for i, phase := range phases {
+		if err := sweepPhase(ctx, phase, delay, delayRand, minTimeout, pollInterval, timeout); err != nil {
+			if remaining := len(phases) - i - 1; remaining > 0 {
+				log.Printf("[WARN] Skipping %d dependent sweep phase(s) after errors", remaining)
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sweepPhase(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
 	var g multierror.Group
+	sem := make(chan struct{}, Concurrency())
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
+		sem <- struct{}{}
+
 		g.Go(func() error {
+			defer func() { <-sem }()
+
 			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
 				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 
 				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
+					if IsThrottlingError(err) {
 						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
 						return resource.RetryableError(err)
 					}
@@ -141,9 +220,67 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 	return g.Wait().ErrorOrNil()
 }
 
+// Concurrency returns the maximum number of resources deleted at once by the sweep orchestrator.
+func Concurrency() int {
+	if v := os.Getenv(conns.EnvVarSweepConcurrency); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+
+		log.Printf("[WARN] Invalid %s value (%s), using default (%d)", conns.EnvVarSweepConcurrency, v, DefaultConcurrency)
+	}
+
+	return DefaultConcurrency
+}
+
+// throttlingErrorCodes are the AWS error codes returned for throttled API requests.
+// See also request.IsErrorThrottle in the AWS Go SDK.
+var throttlingErrorCodes = []string{
+	"EC2ThrottledException",
+	"PriorRequestNotComplete",
+	"ProvisionedThroughputExceededException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestThrottledException",
+	"ThrottledException",
+	"Throttling",
+	"ThrottlingException",
+	"TooManyRequestsException",
+	"TransactionInProgressException",
+}
+
+// IsThrottlingError returns whether the error is an AWS API throttling error.
+func IsThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var awsErr awserr.Error
+
+	if errors.As(err, &awsErr) {
+		return request.IsErrorThrottle(awsErr)
+	}
+
+	// Errors returned as diagnostics by a resource's Delete function only retain the error text,
+	// in which the AWS error code is followed by the error message, e.g. "Throttling: Rate exceeded".
+	message := err.Error()
+
+	for _, code := range throttlingErrorCodes {
+		if strings.Contains(message, code+": ") {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Check sweeper API call error for reasons to skip sweeping
 // These include missing API endpoints and unsupported API calls
 func SkipSweepError(err error) bool {
+	// Ignore API requests blocked in dry-run mode
+	if tfawserr.ErrCodeEquals(err, ErrCodeDryRun) {
+		return true
+	}
 	// Ignore missing API endpoints
 	if tfawserr.ErrMessageContains(err, "RequestError", "send request failed") {
 		return true
@@ -0,0 +1,143 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	PolicyCaseLower = "lower"
+	PolicyCaseUpper = "upper"
+)
+
+// PolicyCases returns the valid tag policy value cases.
+func PolicyCases() []string {
+	return []string{PolicyCaseLower, PolicyCaseUpper}
+}
+
+// PolicyConfig contains tag rules enforced across all resources.
+type PolicyConfig struct {
+	Rules []PolicyRule
+}
+
+// PolicyRule is a tag policy rule for a single tag key.
+type PolicyRule struct {
+	// Key is the tag key, which is matched case-sensitively.
+	Key string
+
+	// Required reports whether resources must have the tag.
+	Required bool
+
+	// ValuePattern, if set, must match the tag value.
+	ValuePattern *regexp.Regexp
+
+	// ValueCase, if set, is the case of the tag value, either "lower" or "upper".
+	ValueCase string
+
+	// ResourceTypes, if set, limits the rule to the Terraform resource types.
+	ResourceTypes []string
+}
+
+// appliesTo returns whether the rule applies to the resource type.
+// Rules limited to resource types do not apply to unknown resource types.
+func (r PolicyRule) appliesTo(resourceType string) bool {
+	if len(r.ResourceTypes) == 0 {
+		return true
+	}
+
+	for _, v := range r.ResourceTypes {
+		if v == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns an error describing every tag policy violation of the resource's tags, if any.
+func (pc *PolicyConfig) Validate(resourceType string, tags KeyValueTags) error {
+	if pc == nil {
+		return nil
+	}
+
+	m := tags.Map()
+	var errs *multierror.Error
+
+	for _, rule := range pc.Rules {
+		if !rule.appliesTo(resourceType) {
+			continue
+		}
+
+		v, ok := m[rule.Key]
+
+		if !ok {
+			if !rule.Required {
+				continue
+			}
+
+			if k, ok := keyEqualFold(m, rule.Key); ok {
+				errs = multierror.Append(errs, fmt.Errorf("tag %q is required by the tag policy, found %q with different case", rule.Key, k))
+			} else {
+				errs = multierror.Append(errs, fmt.Errorf("tag %q is required by the tag policy", rule.Key))
+			}
+
+			continue
+		}
+
+		if rule.ValuePattern != nil && !rule.ValuePattern.MatchString(v) {
+			errs = multierror.Append(errs, fmt.Errorf("tag %q value (%s) does not match the tag policy pattern %q", rule.Key, v, rule.ValuePattern))
+		}
+
+		switch rule.ValueCase {
+		case PolicyCaseLower:
+			if v != strings.ToLower(v) {
+				errs = multierror.Append(errs, fmt.Errorf("tag %q value (%s) must be lower case by the tag policy", rule.Key, v))
+			}
+		case PolicyCaseUpper:
+			if v != strings.ToUpper(v) {
+				errs = multierror.Append(errs, fmt.Errorf("tag %q value (%s) must be upper case by the tag policy", rule.Key, v))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// keyEqualFold returns the first key, in sorted order, equal to the given key under case-folding.
+func keyEqualFold(m map[string]string, key string) (string, bool) {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+
+	return "", false
+}
+
+type resourceTypeContextKey struct{}
+
+// NewResourceTypeContext returns a context carrying the Terraform resource type,
+// used to determine which tag policy rules apply to a resource.
+func NewResourceTypeContext(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey{}, resourceType)
+}
+
+// ResourceTypeFromContext returns the Terraform resource type carried by the context, if any.
+func ResourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeContextKey{}).(string)
+
+	return v
+}
@@ -0,0 +1,143 @@
+package tags
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		resourceType string
+		tags         KeyValueTags
+		wantErrs     []string
+	}{
+		{
+			name: "nil config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "required present",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{{Key: "CostCenter", Required: true}},
+			},
+			tags: New(map[string]string{
+				"CostCenter": "1234",
+			}),
+		},
+		{
+			name: "required missing",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{{Key: "CostCenter", Required: true}},
+			},
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			wantErrs: []string{`tag "CostCenter" is required by the tag policy`},
+		},
+		{
+			name: "required different case",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{{Key: "CostCenter", Required: true}},
+			},
+			tags: New(map[string]string{
+				"costcenter": "1234",
+			}),
+			wantErrs: []string{`found "costcenter" with different case`},
+		},
+		{
+			name: "optional missing",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{{Key: "CostCenter", ValuePattern: regexp.MustCompile(`^[0-9]{4}$`)}},
+			},
+			tags: New(map[string]string{}),
+		},
+		{
+			name: "value pattern",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{{Key: "CostCenter", ValuePattern: regexp.MustCompile(`^[0-9]{4}$`)}},
+			},
+			tags: New(map[string]string{
+				"CostCenter": "finance",
+			}),
+			wantErrs: []string{`tag "CostCenter" value (finance) does not match the tag policy pattern "^[0-9]{4}$"`},
+		},
+		{
+			name: "value case",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "Environment", ValueCase: PolicyCaseLower},
+					{Key: "Team", ValueCase: PolicyCaseUpper},
+				},
+			},
+			tags: New(map[string]string{
+				"Environment": "Production",
+				"Team":        "Platform",
+			}),
+			wantErrs: []string{
+				`tag "Environment" value (Production) must be lower case`,
+				`tag "Team" value (Platform) must be upper case`,
+			},
+		},
+		{
+			name: "resource type applies",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{{Key: "CostCenter", Required: true, ResourceTypes: []string{"aws_instance"}}},
+			},
+			resourceType: "aws_instance",
+			tags:         New(map[string]string{}),
+			wantErrs:     []string{`tag "CostCenter" is required by the tag policy`},
+		},
+		{
+			name: "resource type does not apply",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{{Key: "CostCenter", Required: true, ResourceTypes: []string{"aws_instance"}}},
+			},
+			resourceType: "aws_vpc",
+			tags:         New(map[string]string{}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.policyConfig.Validate(testCase.resourceType, testCase.tags)
+
+			if len(testCase.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			for _, want := range testCase.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected error containing %q, got: %s", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceTypeContext(t *testing.T) {
+	ctx := context.Background()
+
+	if got := ResourceTypeFromContext(ctx); got != "" {
+		t.Errorf("got %q, expected empty resource type", got)
+	}
+
+	ctx = NewResourceTypeContext(ctx, "aws_instance")
+
+	if got, want := ResourceTypeFromContext(ctx), "aws_instance"; got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+}
@@ -20,9 +20,12 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+// The merged tags are also validated against the provider-level tag policy,
+// whose rules may be limited to the resource type carried by the context.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagPolicyConfig := meta.(*conns.AWSClient).TagPolicyConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -30,7 +33,16 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	mergedTags := defaultTagsConfig.MergeTags(resourceTags)
+
+	// Tags are unknown until apply when any value is interpolated from another resource.
+	if diff.NewValueKnown("tags") {
+		if err := tagPolicyConfig.Validate(tftags.ResourceTypeFromContext(ctx), mergedTags); err != nil {
+			return fmt.Errorf("tags do not comply with the tag policy of the provider: %w", err)
+		}
+	}
+
+	allTags := mergedTags.IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
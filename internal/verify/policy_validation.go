@@ -0,0 +1,142 @@
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ValidatePolicyDiff returns a CustomizeDiff function which validates the IAM
+// policy documents in the specified attributes with IAM Access Analyzer when
+// policy validation is enabled in the provider configuration.
+// Attributes nested in a block are specified as "block.*.attribute".
+// Only known, changed policy documents are validated.
+func ValidatePolicyDiff(policyType string, keys ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		policyValidationConfig := meta.(*conns.AWSClient).PolicyValidationConfig
+
+		if policyValidationConfig == nil {
+			return nil
+		}
+
+		conn := meta.(*conns.AWSClient).AccessAnalyzerConn()
+
+		for _, key := range keys {
+			parts := strings.SplitN(key, ".*.", 2)
+
+			if !diff.HasChange(parts[0]) || !diff.NewValueKnown(parts[0]) {
+				continue
+			}
+
+			for _, policy := range policyDocumentsFromDiff(diff, parts) {
+				input := &accessanalyzer.ValidatePolicyInput{
+					PolicyDocument: aws.String(policy),
+					PolicyType:     aws.String(policyType),
+				}
+
+				if policyValidationConfig.Locale != "" {
+					input.Locale = aws.String(policyValidationConfig.Locale)
+				}
+
+				var findings []*accessanalyzer.ValidatePolicyFinding
+
+				err := conn.ValidatePolicyPages(input, func(page *accessanalyzer.ValidatePolicyOutput, lastPage bool) bool {
+					if page == nil {
+						return !lastPage
+					}
+
+					findings = append(findings, page.Findings...)
+
+					return !lastPage
+				})
+
+				if err != nil {
+					return fmt.Errorf("error validating %s with IAM Access Analyzer: %w", key, err)
+				}
+
+				if err := policyValidationFindingsError(policyValidationConfig, key, findings); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// policyDocumentsFromDiff returns the new policy documents of a top-level attribute,
+// or of an attribute nested in a block when the key has been split into two parts.
+// Values which are not JSON, e.g. unknown nested values, are skipped.
+func policyDocumentsFromDiff(diff *schema.ResourceDiff, parts []string) []string {
+	var values []interface{}
+
+	if len(parts) == 1 {
+		values = append(values, diff.Get(parts[0]))
+	} else {
+		var blocks []interface{}
+
+		switch v := diff.Get(parts[0]).(type) {
+		case *schema.Set:
+			blocks = v.List()
+		case []interface{}:
+			blocks = v
+		}
+
+		for _, tfMapRaw := range blocks {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				values = append(values, tfMap[parts[1]])
+			}
+		}
+	}
+
+	var policies []string
+
+	for _, v := range values {
+		if v, ok := v.(string); ok && v != "" && json.Valid([]byte(v)) {
+			policies = append(policies, v)
+		}
+	}
+
+	return policies
+}
+
+// policyValidationFindingsError returns an error describing the findings whose type
+// fails the plan, logging any others.
+func policyValidationFindingsError(config *conns.PolicyValidationConfig, key string, findings []*accessanalyzer.ValidatePolicyFinding) error {
+	var failures []string
+
+	for _, finding := range findings {
+		if finding == nil {
+			continue
+		}
+
+		description := fmt.Sprintf("%s %s", aws.StringValue(finding.FindingType), aws.StringValue(finding.IssueCode))
+
+		if len(finding.Locations) > 0 && finding.Locations[0] != nil && finding.Locations[0].Span != nil && finding.Locations[0].Span.Start != nil {
+			start := finding.Locations[0].Span.Start
+			description += fmt.Sprintf(" (line %d, column %d)", aws.Int64Value(start.Line), aws.Int64Value(start.Column))
+		}
+
+		description += fmt.Sprintf(": %s", aws.StringValue(finding.FindingDetails))
+
+		if !config.FailsOn(aws.StringValue(finding.FindingType)) {
+			log.Printf("[WARN] IAM Access Analyzer finding for %s: %s", key, description)
+			continue
+		}
+
+		failures = append(failures, description)
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s has IAM Access Analyzer policy validation findings:\n\n%s", key, strings.Join(failures, "\n"))
+}
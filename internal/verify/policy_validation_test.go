@@ -0,0 +1,86 @@
+package verify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestPolicyValidationFindingsError(t *testing.T) {
+	config := &conns.PolicyValidationConfig{
+		FailOnFindingTypes: []string{accessanalyzer.ValidatePolicyFindingTypeError, accessanalyzer.ValidatePolicyFindingTypeSecurityWarning},
+	}
+
+	testCases := []struct {
+		Name          string
+		Findings      []*accessanalyzer.ValidatePolicyFinding
+		ExpectedError string
+	}{
+		{
+			Name: "no findings",
+		},
+		{
+			Name: "suggestion only",
+			Findings: []*accessanalyzer.ValidatePolicyFinding{
+				{
+					FindingDetails: aws.String("Add a Version element."),
+					FindingType:    aws.String(accessanalyzer.ValidatePolicyFindingTypeSuggestion),
+					IssueCode:      aws.String("MISSING_VERSION"),
+				},
+			},
+		},
+		{
+			Name: "error with location",
+			Findings: []*accessanalyzer.ValidatePolicyFinding{
+				{
+					FindingDetails: aws.String("The action s3:Get does not exist."),
+					FindingType:    aws.String(accessanalyzer.ValidatePolicyFindingTypeError),
+					IssueCode:      aws.String("INVALID_ACTION"),
+					Locations: []*accessanalyzer.Location{
+						{
+							Span: &accessanalyzer.Span{
+								Start: &accessanalyzer.Position{Column: aws.Int64(15), Line: aws.Int64(4), Offset: aws.Int64(60)},
+								End:   &accessanalyzer.Position{Column: aws.Int64(23), Line: aws.Int64(4), Offset: aws.Int64(68)},
+							},
+						},
+					},
+				},
+				{
+					FindingDetails: aws.String("Add a Version element."),
+					FindingType:    aws.String(accessanalyzer.ValidatePolicyFindingTypeSuggestion),
+					IssueCode:      aws.String("MISSING_VERSION"),
+				},
+			},
+			ExpectedError: "ERROR INVALID_ACTION (line 4, column 15): The action s3:Get does not exist.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := policyValidationFindingsError(config, "policy", testCase.Findings)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("expected error containing %q, got %q", testCase.ExpectedError, err)
+			}
+
+			if strings.Contains(err.Error(), "MISSING_VERSION") {
+				t.Errorf("unexpected suggestion in error: %q", err)
+			}
+		})
+	}
+}
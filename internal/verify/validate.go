@@ -229,6 +229,20 @@ func ValidOnceAWeekWindowFormat(v interface{}, k string) (ws []string, errors []
 	return
 }
 
+// ValidRegionName validates that a string looks like an AWS Region name, e.g. us-west-2 or us-gov-east-1.
+func ValidRegionName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	pattern := `^[a-z]{2}(-[a-z]+)+-\d$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't look like AWS Region (e.g. us-west-2): %q",
+			k, value))
+	}
+
+	return
+}
+
 func ValidStringIsJSONOrYAML(v interface{}, k string) (ws []string, errors []error) {
 	if looksLikeJsonString(v) {
 		if _, err := structure.NormalizeJsonString(v); err != nil {
@@ -137,6 +137,36 @@ func TestValidAccountID(t *testing.T) {
 	}
 }
 
+func TestValidRegionName(t *testing.T) {
+	validNames := []string{
+		"ap-northeast-1",
+		"cn-northwest-1",
+		"us-gov-east-1",
+		"us-iso-east-1",
+		"us-west-2",
+	}
+	for _, v := range validNames {
+		_, errors := ValidRegionName(v, "region_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid AWS Region name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"us-west",
+		"us-west-2a",
+		"US-WEST-2",
+		"uswest2",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidRegionName(v, "region_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid AWS Region name", v)
+		}
+	}
+}
+
 func TestValidARN(t *testing.T) {
 	v := ""
 	_, errors := ValidARN(v, "arn")
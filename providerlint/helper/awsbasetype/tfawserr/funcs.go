@@ -0,0 +1,6 @@
+package tfawserr
+
+const (
+	FuncNameErrCodeEquals      = `ErrCodeEquals`
+	FuncNameErrMessageContains = `ErrMessageContains`
+)
@@ -0,0 +1,6 @@
+package tfresource
+
+const (
+	FuncNameNotFound = `NotFound`
+	FuncNameTimedOut = `TimedOut`
+)
@@ -0,0 +1,104 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of list, set, or map values with ignored error
+
+The AWSR003 analyzer reports when a (schema.ResourceData).Set() call with a
+slice, map, or *schema.Set value does not check the returned error, either by
+discarding the call result or assigning it to the blank identifier. Unlike
+primitive values, these aggregate values can fail conversion into the
+Terraform state, which would otherwise silently break drift detection.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var callExpr *ast.CallExpr
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return
+			}
+
+			if ident, ok := n.Lhs[0].(*ast.Ident); !ok || ident.Name != "_" {
+				return
+			}
+
+			callExpr, _ = n.Rhs[0].(*ast.CallExpr)
+		case *ast.ExprStmt:
+			callExpr, _ = n.X.(*ast.CallExpr)
+		}
+
+		if callExpr == nil {
+			return
+		}
+
+		if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "Set") {
+			return
+		}
+
+		if len(callExpr.Args) < 2 {
+			return
+		}
+
+		if !isAggregateType(pass.TypesInfo.TypeOf(callExpr.Args[1])) {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: d.Set() error for list, set, or map value should be checked", analyzerName)
+	})
+
+	return nil, nil
+}
+
+// isAggregateType returns true for values stored as list, set, or map attributes.
+func isAggregateType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	if schema.IsTypeSet(t) {
+		return true
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Map, *types.Slice:
+		return true
+	}
+
+	return false
+}
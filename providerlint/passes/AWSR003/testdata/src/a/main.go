@@ -0,0 +1,60 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	var d schema.ResourceData
+
+	testSet := schema.NewSet(schema.HashString, nil)
+
+	/* Passing cases */
+
+	d.Set("test", "value")
+
+	d.Set("test", 1)
+
+	d.Set("test", true)
+
+	_ = d.Set("test", "value")
+
+	if err := d.Set("test", []interface{}{}); err != nil {
+		return
+	}
+
+	if err := d.Set("test", map[string]interface{}{}); err != nil {
+		return
+	}
+
+	if err := d.Set("test", testSet); err != nil {
+		return
+	}
+
+	err := d.Set("test", testFunc())
+
+	if err != nil {
+		return
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR003
+	d.Set("test", []interface{}{})
+
+	d.Set("test", []interface{}{}) //lintignore:AWSR003
+
+	/* Failing cases */
+
+	d.Set("test", []interface{}{})          // want "d.Set\\(\\) error for list, set, or map value should be checked"
+	d.Set("test", []string{"value"})        // want "d.Set\\(\\) error for list, set, or map value should be checked"
+	d.Set("test", map[string]interface{}{}) // want "d.Set\\(\\) error for list, set, or map value should be checked"
+	d.Set("test", map[string]string{})      // want "d.Set\\(\\) error for list, set, or map value should be checked"
+	d.Set("test", testSet)                  // want "d.Set\\(\\) error for list, set, or map value should be checked"
+	d.Set("test", testFunc())               // want "d.Set\\(\\) error for list, set, or map value should be checked"
+	_ = d.Set("test", []interface{}{})      // want "d.Set\\(\\) error for list, set, or map value should be checked"
+}
+
+func testFunc() []interface{} {
+	return nil
+}
@@ -0,0 +1,125 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for AWS Go SDK calls without context in context-aware CRUD functions
+
+The AWSR004 analyzer reports when a CreateContext, ReadContext, UpdateContext,
+or DeleteContext function calls an AWS Go SDK service client method that has a
+WithContext variant, e.g. DescribeVpcs() instead of DescribeVpcsWithContext().
+Calls without the context are not cancelled when Terraform is interrupted and
+ignore any deadline attached to the context.
+`
+
+const analyzerName = "AWSR004"
+
+const awsServicePackagePrefix = `github.com/aws/aws-sdk-go/service/`
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+
+	for _, crudFunc := range crudFuncs {
+		if crudFunc.Body == nil {
+			continue
+		}
+
+		// Only functions with a context.Context parameter.
+		if !astutils.IsFieldListTypePackageType(crudFunc.Type.Params, 0, pass.TypesInfo, "context", "Context") {
+			continue
+		}
+
+		ast.Inspect(crudFunc.Body, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+
+			if !ok {
+				return true
+			}
+
+			methodName := selectorExpr.Sel.Name
+
+			if strings.HasSuffix(methodName, "WithContext") {
+				return true
+			}
+
+			if !isServiceClientMethodWithContext(pass, selectorExpr) {
+				return true
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+				return true
+			}
+
+			pass.Reportf(callExpr.Pos(), "%s: prefer %sWithContext() in context-aware CRUD function", analyzerName, methodName)
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isServiceClientMethodWithContext returns true if the selector is a method
+// of an AWS Go SDK service client that has a WithContext variant.
+func isServiceClientMethodWithContext(pass *analysis.Pass, selectorExpr *ast.SelectorExpr) bool {
+	selection, ok := pass.TypesInfo.Selections[selectorExpr]
+
+	if !ok || selection.Kind() != types.MethodVal {
+		return false
+	}
+
+	recv := selection.Recv()
+
+	if pointer, ok := recv.(*types.Pointer); ok {
+		recv = pointer.Elem()
+	}
+
+	named, ok := recv.(*types.Named)
+
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	// HasPrefix after trimming any vendor directory
+	pkgPath := named.Obj().Pkg().Path()
+
+	if i := strings.LastIndex(pkgPath, "/vendor/"); i != -1 {
+		pkgPath = pkgPath[i+len("/vendor/"):]
+	}
+
+	if !strings.HasPrefix(pkgPath, awsServicePackagePrefix) {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(selection.Recv(), true, named.Obj().Pkg(), selectorExpr.Sel.Name+"WithContext")
+
+	_, ok = obj.(*types.Func)
+
+	return ok
+}
@@ -0,0 +1,75 @@
+package a
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var conn *s3.S3
+
+/* Passing cases */
+
+func passingWithContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := &s3.HeadBucketInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, _ = conn.HeadBucketWithContext(ctx, input)
+
+	_ = conn.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		return !lastPage
+	})
+
+	return nil
+}
+
+func passingNoContextVariant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, _ = conn.HeadBucketRequest(&s3.HeadBucketInput{})
+
+	_ = aws.StringValue(conn.Config.Region)
+
+	return nil
+}
+
+func passingNotContextAware(d *schema.ResourceData, meta interface{}) error {
+	_, err := conn.HeadBucket(&s3.HeadBucketInput{})
+
+	return err
+}
+
+func passingNotCRUDFunc(ctx context.Context) error {
+	_, err := conn.HeadBucket(&s3.HeadBucketInput{})
+
+	return err
+}
+
+/* Comment ignored cases */
+
+func commentIgnored(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	//lintignore:AWSR004
+	_, _ = conn.HeadBucket(&s3.HeadBucketInput{})
+
+	_, _ = conn.HeadBucket(&s3.HeadBucketInput{}) //lintignore:AWSR004
+
+	return nil
+}
+
+/* Failing cases */
+
+func failing(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, _ = conn.HeadBucket(&s3.HeadBucketInput{}) // want "prefer HeadBucketWithContext\\(\\) in context-aware CRUD function"
+
+	_ = conn.ListObjectsV2Pages(&s3.ListObjectsV2Input{}, func(page *s3.ListObjectsV2Output, lastPage bool) bool { // want "prefer ListObjectsV2PagesWithContext\\(\\) in context-aware CRUD function"
+		return !lastPage
+	})
+
+	func() {
+		_ = conn.WaitUntilBucketExists(&s3.HeadBucketInput{}) // want "prefer WaitUntilBucketExistsWithContext\\(\\) in context-aware CRUD function"
+	}()
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package AWSR005
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/resource"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/tfresource"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for resource.Retry() calls without tfresource.TimedOut() fallback
+
+The AWSR005 analyzer reports when the error returned by a resource.Retry() or
+resource.RetryContext() call is not subsequently checked with
+tfresource.TimedOut(). When the retry function is not run before the timeout
+expires, e.g. due to a slow or paused system, the returned timeout error has
+no last error and the operation should be attempted one final time.
+
+Test files are not checked.
+`
+
+const analyzerName = "AWSR005"
+
+const (
+	funcNameRetry        = `Retry`
+	funcNameRetryContext = `RetryContext`
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.BlockStmt)(nil),
+		(*ast.CaseClause)(nil),
+		(*ast.CommClause)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		if strings.HasSuffix(pass.Fset.File(n.Pos()).Name(), "_test.go") {
+			return
+		}
+
+		var stmts []ast.Stmt
+
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			stmts = n.List
+		case *ast.CaseClause:
+			stmts = n.Body
+		case *ast.CommClause:
+			stmts = n.Body
+		}
+
+		for i, stmt := range stmts {
+			var callExpr *ast.CallExpr
+			var errObj types.Object
+			var scope []ast.Stmt
+
+			switch stmt := stmt.(type) {
+			case *ast.AssignStmt:
+				callExpr, errObj = retryAssignment(pass, stmt)
+				scope = stmts[i+1:]
+			case *ast.IfStmt:
+				assignStmt, ok := stmt.Init.(*ast.AssignStmt)
+
+				if !ok {
+					continue
+				}
+
+				callExpr, errObj = retryAssignment(pass, assignStmt)
+				scope = []ast.Stmt{stmt}
+			case *ast.ExprStmt:
+				callExpr = retryCallExpr(pass, stmt.X)
+			case *ast.ReturnStmt:
+				for _, result := range stmt.Results {
+					if callExpr = retryCallExpr(pass, result); callExpr != nil {
+						break
+					}
+				}
+			}
+
+			if callExpr == nil {
+				continue
+			}
+
+			if errObj != nil && hasTimedOutCall(pass, scope, errObj) {
+				continue
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+				continue
+			}
+
+			pass.Reportf(callExpr.Pos(), "%s: missing tfresource.TimedOut() check after resource.Retry()", analyzerName)
+		}
+	})
+
+	return nil, nil
+}
+
+// retryAssignment returns the resource.Retry() call and the object of the
+// error variable it is assigned to, if any.
+func retryAssignment(pass *analysis.Pass, assignStmt *ast.AssignStmt) (*ast.CallExpr, types.Object) {
+	if len(assignStmt.Lhs) != 1 || len(assignStmt.Rhs) != 1 {
+		return nil, nil
+	}
+
+	callExpr := retryCallExpr(pass, assignStmt.Rhs[0])
+
+	if callExpr == nil {
+		return nil, nil
+	}
+
+	ident, ok := assignStmt.Lhs[0].(*ast.Ident)
+
+	if !ok {
+		return callExpr, nil
+	}
+
+	return callExpr, pass.TypesInfo.ObjectOf(ident)
+}
+
+func retryCallExpr(pass *analysis.Pass, e ast.Expr) *ast.CallExpr {
+	callExpr, ok := e.(*ast.CallExpr)
+
+	if !ok {
+		return nil
+	}
+
+	if !resource.IsFunc(callExpr.Fun, pass.TypesInfo, funcNameRetry) && !resource.IsFunc(callExpr.Fun, pass.TypesInfo, funcNameRetryContext) {
+		return nil
+	}
+
+	return callExpr
+}
+
+// hasTimedOutCall returns true if any of the statements call
+// tfresource.TimedOut() with the error variable.
+func hasTimedOutCall(pass *analysis.Pass, stmts []ast.Stmt, errObj types.Object) bool {
+	var found bool
+
+	for _, stmt := range stmts {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			if !tfresource.IsFunc(callExpr.Fun, pass.TypesInfo, tfresource.FuncNameTimedOut) || len(callExpr.Args) != 1 {
+				return true
+			}
+
+			if ident, ok := callExpr.Args[0].(*ast.Ident); ok && pass.TypesInfo.ObjectOf(ident) == errObj {
+				found = true
+				return false
+			}
+
+			return true
+		})
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,14 @@
+package AWSR005
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// The testdata package is nested under the provider import path so it can
+// import the internal tfresource package, which is stubbed.
+func TestAWSR005(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "github.com/hashicorp/terraform-provider-aws/internal/service/a")
+}
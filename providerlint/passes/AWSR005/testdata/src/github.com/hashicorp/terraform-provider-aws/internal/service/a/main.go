@@ -0,0 +1,88 @@
+package a
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func retryFunc() *resource.RetryError {
+	return nil
+}
+
+func operation() error {
+	return nil
+}
+
+func f(ctx context.Context) error {
+	/* Passing cases */
+
+	err := resource.Retry(1*time.Minute, retryFunc)
+
+	if tfresource.TimedOut(err) {
+		err = operation()
+	}
+
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = operation()
+	}
+
+	if err := resource.Retry(1*time.Minute, retryFunc); tfresource.TimedOut(err) {
+		_ = operation()
+	}
+
+	switch {
+	default:
+		err := resource.Retry(1*time.Minute, retryFunc)
+
+		if tfresource.TimedOut(err) {
+			_ = operation()
+		}
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	err = resource.Retry(1*time.Minute, retryFunc)
+
+	err = resource.Retry(1*time.Minute, retryFunc) //lintignore:AWSR005
+
+	/* Failing cases */
+
+	err = resource.Retry(1*time.Minute, retryFunc) // want "missing tfresource.TimedOut\\(\\) check after resource.Retry\\(\\)"
+
+	if err != nil {
+		return err
+	}
+
+	err = resource.RetryContext(ctx, 1*time.Minute, retryFunc) // want "missing tfresource.TimedOut\\(\\) check after resource.Retry\\(\\)"
+
+	if err != nil {
+		return err
+	}
+
+	otherErr := operation()
+
+	err = resource.Retry(1*time.Minute, retryFunc) // want "missing tfresource.TimedOut\\(\\) check after resource.Retry\\(\\)"
+
+	if tfresource.TimedOut(otherErr) {
+		return err
+	}
+
+	if err := resource.Retry(1*time.Minute, retryFunc); err != nil { // want "missing tfresource.TimedOut\\(\\) check after resource.Retry\\(\\)"
+		return err
+	}
+
+	switch {
+	default:
+		resource.Retry(1*time.Minute, retryFunc) // want "missing tfresource.TimedOut\\(\\) check after resource.Retry\\(\\)"
+	}
+
+	return resource.Retry(1*time.Minute, retryFunc) // want "missing tfresource.TimedOut\\(\\) check after resource.Retry\\(\\)"
+}
@@ -0,0 +1,209 @@
+package AWSR006
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsbasetype/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/tfresource"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for Read functions returning on NotFound errors without d.SetId("")
+
+The AWSR006 analyzer reports when a resource Read function checks for a "not
+found" error with tfresource.NotFound(), tfawserr.ErrCodeEquals(), or
+tfawserr.ErrMessageContains() and returns without calling d.SetId(""). The
+resource must be removed from the Terraform state so that it can be recreated
+instead of reporting an error or leaving a stale resource in the state.
+
+Read functions are identified by name, e.g. resourceExampleThingRead.
+Data source Read functions, whose names begin with "dataSource", are not
+checked.
+`
+
+const analyzerName = "AWSR006"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+
+	for _, crudFunc := range crudFuncs {
+		funcDecl := crudFunc.AstFuncDecl
+
+		if funcDecl == nil || funcDecl.Body == nil {
+			continue
+		}
+
+		if !isResourceReadFuncName(funcDecl.Name.Name) {
+			continue
+		}
+
+		ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				// Not found errors inside closures, e.g. retry functions, are handled differently.
+				return false
+			case *ast.IfStmt:
+				if !hasNotFoundCheck(pass.TypesInfo, n.Cond) {
+					return true
+				}
+
+				if !hasReturnStmt(n.Body) || hasSetIdEmptyCall(pass.TypesInfo, n.Body) {
+					return true
+				}
+
+				if commentIgnorer.ShouldIgnore(analyzerName, n) {
+					return true
+				}
+
+				pass.Reportf(n.Cond.Pos(), "%s: Read function should call d.SetId(\"\") before returning on NotFound error", analyzerName)
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+func isResourceReadFuncName(name string) bool {
+	return strings.HasSuffix(name, "Read") && !strings.HasPrefix(name, "dataSource")
+}
+
+// hasNotFoundCheck returns true if the expression contains a non-negated not found error check.
+func hasNotFoundCheck(info *types.Info, e ast.Expr) bool {
+	var found bool
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.UnaryExpr:
+			// e.g. err != nil && !tfresource.NotFound(err)
+			if n.Op == token.NOT {
+				return false
+			}
+		case *ast.CallExpr:
+			if isNotFoundCheckCall(info, n) {
+				found = true
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return found
+}
+
+func isNotFoundCheckCall(info *types.Info, callExpr *ast.CallExpr) bool {
+	if tfresource.IsFunc(callExpr.Fun, info, tfresource.FuncNameNotFound) {
+		return true
+	}
+
+	if !tfawserr.IsFunc(callExpr.Fun, info, tfawserr.FuncNameErrCodeEquals) && !tfawserr.IsFunc(callExpr.Fun, info, tfawserr.FuncNameErrMessageContains) {
+		return false
+	}
+
+	for _, arg := range callExpr.Args[1:] {
+		if isNotFoundErrorCode(arg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNotFoundErrorCode returns true if the expression looks like a not found error code or message.
+func isNotFoundErrorCode(e ast.Expr) bool {
+	var value string
+
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return false
+		}
+
+		v, err := strconv.Unquote(e.Value)
+
+		if err != nil {
+			return false
+		}
+
+		value = v
+	case *ast.Ident:
+		value = e.Name
+	case *ast.SelectorExpr:
+		value = e.Sel.Name
+	default:
+		return false
+	}
+
+	value = strings.ToLower(value)
+
+	for _, s := range []string{"notfound", "not found", "nosuch", "does not exist"} {
+		if strings.Contains(value, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasReturnStmt(block *ast.BlockStmt) bool {
+	for _, stmt := range block.List {
+		if _, ok := stmt.(*ast.ReturnStmt); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasSetIdEmptyCall(info *types.Info, block *ast.BlockStmt) bool {
+	var found bool
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		if !schema.IsReceiverMethod(callExpr.Fun, info, schema.TypeNameResourceData, "SetId") || len(callExpr.Args) != 1 {
+			return true
+		}
+
+		if basicLit, ok := callExpr.Args[0].(*ast.BasicLit); ok && (basicLit.Value == `""` || basicLit.Value == "``") {
+			found = true
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
@@ -0,0 +1,15 @@
+package AWSR006
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// The testdata package is nested under the provider import path so it can
+// import the internal tfresource package. The tfresource and tfawserr
+// packages are stubbed.
+func TestAWSR006(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "github.com/hashicorp/terraform-provider-aws/internal/service/a")
+}
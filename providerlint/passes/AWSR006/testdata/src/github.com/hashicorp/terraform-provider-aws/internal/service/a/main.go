@@ -0,0 +1,133 @@
+package a
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const ErrCodeResourceNotFoundException = "ResourceNotFoundException"
+
+func find() error {
+	return nil
+}
+
+/* Passing cases */
+
+func resourcePassingRead(d *schema.ResourceData, meta interface{}) error {
+	err := find()
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeResourceNotFoundException) {
+		d.SetId("")
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, "ValidationException", "does not exist") {
+		d.SetId("")
+		return nil
+	}
+
+	// Optional configuration falls through without returning.
+	if tfawserr.ErrCodeEquals(err, "NoSuchConfiguration") {
+		err = nil
+	}
+
+	if err != nil && !tfresource.NotFound(err) {
+		return err
+	}
+
+	if tfawserr.ErrCodeEquals(err, "ValidationException") {
+		return err
+	}
+
+	return nil
+}
+
+func resourcePassingContextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := resource.Retry(0, func() *resource.RetryError {
+		err := find()
+
+		if tfresource.NotFound(err) {
+			return resource.RetryableError(err)
+		}
+
+		return nil
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourcePassingCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := find(); tfresource.NotFound(err) {
+		return errors.New("not found")
+	}
+
+	return nil
+}
+
+func dataSourcePassingRead(d *schema.ResourceData, meta interface{}) error {
+	if err := find(); tfresource.NotFound(err) {
+		return errors.New("no matching resource found")
+	}
+
+	return nil
+}
+
+/* Comment ignored cases */
+
+func resourceCommentIgnoredRead(d *schema.ResourceData, meta interface{}) error {
+	err := find()
+
+	//lintignore:AWSR006
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	return nil
+}
+
+/* Failing cases */
+
+func resourceFailingRead(d *schema.ResourceData, meta interface{}) error {
+	err := find()
+
+	if !d.IsNewResource() && tfresource.NotFound(err) { // want "Read function should call d.SetId\\(\"\"\\) before returning on NotFound error"
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeResourceNotFoundException) { // want "Read function should call d.SetId\\(\"\"\\) before returning on NotFound error"
+		return err
+	}
+
+	if tfawserr.ErrMessageContains(err, "ValidationException", "not found") { // want "Read function should call d.SetId\\(\"\"\\) before returning on NotFound error"
+		return nil
+	}
+
+	return nil
+}
+
+func resourceFailingContextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := find(); tfresource.NotFound(err) { // want "Read function should call d.SetId\\(\"\"\\) before returning on NotFound error"
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
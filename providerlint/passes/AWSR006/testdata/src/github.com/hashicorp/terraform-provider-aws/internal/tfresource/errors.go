@@ -0,0 +1,9 @@
+package tfresource
+
+func NotFound(err error) bool {
+	return false
+}
+
+func TimedOut(err error) bool {
+	return false
+}